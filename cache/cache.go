@@ -0,0 +1,119 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kulics/gollection/option"
+)
+
+const invalidCapacity = "capacity must be positive"
+
+// Cache is the common interface of LRU, LFU and TTL.
+type Cache[K comparable, V any] interface {
+	// Return the value of the key and record the access.
+	Get(key K) option.Option[V]
+	// Return the value of the key without recording the access.
+	Peek(key K) option.Option[V]
+	// Add the value of the key, evict an entry when the cache is full.
+	Add(key K, value V)
+	// Remove the key, return the removed value when the key has been included.
+	Remove(key K) option.Option[V]
+	// Returns true if the key is included in the cache.
+	Contains(key K) bool
+	// Return the number of entries of cache.
+	Count() int
+	// Return the maximum number of entries of cache.
+	Capacity() int
+	// Clears all entries, the eviction callback is not called.
+	Clear()
+	// Return the statistics of cache.
+	Stats() Stats
+}
+
+// Stats is the statistics of a Cache.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+// Clock provides the current time for TTL, it can be replaced in tests.
+type Clock interface {
+	Now() time.Time
+}
+
+// Return the Clock of system time.
+func SystemClock() Clock {
+	return systemClock{}
+}
+
+type systemClock struct{}
+
+func (a systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// Wrap the cache to be safe for concurrent use, all operations are guarded by a lock.
+func Synchronized[K comparable, V any](cache Cache[K, V]) Cache[K, V] {
+	return &synchronized[K, V]{cache: cache}
+}
+
+type synchronized[K comparable, V any] struct {
+	lock  sync.Mutex
+	cache Cache[K, V]
+}
+
+func (a *synchronized[K, V]) Get(key K) option.Option[V] {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.cache.Get(key)
+}
+
+func (a *synchronized[K, V]) Peek(key K) option.Option[V] {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.cache.Peek(key)
+}
+
+func (a *synchronized[K, V]) Add(key K, value V) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.cache.Add(key, value)
+}
+
+func (a *synchronized[K, V]) Remove(key K) option.Option[V] {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.cache.Remove(key)
+}
+
+func (a *synchronized[K, V]) Contains(key K) bool {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.cache.Contains(key)
+}
+
+func (a *synchronized[K, V]) Count() int {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.cache.Count()
+}
+
+func (a *synchronized[K, V]) Capacity() int {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.cache.Capacity()
+}
+
+func (a *synchronized[K, V]) Clear() {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.cache.Clear()
+}
+
+func (a *synchronized[K, V]) Stats() Stats {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.cache.Stats()
+}
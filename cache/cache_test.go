@@ -0,0 +1,172 @@
+package cache
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (a *fakeClock) Now() time.Time {
+	return a.now
+}
+
+func (a *fakeClock) Advance(d time.Duration) {
+	a.now = a.now.Add(d)
+}
+
+func TestLRU(t *testing.T) {
+	var cache = MakeLRU[string, int](2)
+	var evicted = ""
+	cache.OnEvict(func(k string, v int) {
+		evicted += k
+	})
+	cache.Add("a", 1)
+	cache.Add("b", 2)
+	if cache.Get("a").OrPanic() != 1 {
+		t.Fatal("lru get error")
+	}
+	cache.Add("c", 3)
+	if evicted != "b" || cache.Contains("b") || cache.Count() != 2 {
+		t.Fatal("lru evict error")
+	}
+	if cache.Peek("a").OrPanic() != 1 {
+		t.Fatal("lru peek error")
+	}
+	cache.Add("d", 4)
+	if evicted != "ba" {
+		t.Fatal("lru peek changed recency")
+	}
+	cache.Add("c", 5)
+	cache.Add("e", 6)
+	if evicted != "bad" || cache.Peek("c").OrPanic() != 5 {
+		t.Fatal("lru update recency error")
+	}
+	if cache.Get("x").IsSome() {
+		t.Fatal("lru get absent error")
+	}
+	if stats := cache.Stats(); stats.Hits != 1 || stats.Misses != 1 || stats.Evictions != 3 {
+		t.Fatal("lru stats error")
+	}
+	if cache.Remove("c").OrPanic() != 5 || cache.Count() != 1 {
+		t.Fatal("lru remove error")
+	}
+	cache.Clear()
+	if cache.Count() != 0 || evicted != "bad" {
+		t.Fatal("lru clear error")
+	}
+}
+
+func TestLFU(t *testing.T) {
+	var cache = MakeLFU[string, int](2)
+	var evicted = ""
+	cache.OnEvict(func(k string, v int) {
+		evicted += k
+	})
+	cache.Add("a", 1)
+	cache.Add("b", 2)
+	cache.Get("a")
+	cache.Get("a")
+	cache.Get("b")
+	cache.Add("c", 3)
+	if evicted != "b" || !cache.Contains("a") || !cache.Contains("c") {
+		t.Fatal("lfu evict error")
+	}
+	cache.Peek("c")
+	cache.Peek("c")
+	cache.Add("d", 4)
+	if evicted != "bc" {
+		t.Fatal("lfu peek changed frequency")
+	}
+	cache.Get("d")
+	cache.Add("e", 5)
+	if evicted != "bcd" {
+		t.Fatal("lfu evict least recently used in same frequency error")
+	}
+	if cache.Get("a").OrPanic() != 1 || cache.Get("z").IsSome() {
+		t.Fatal("lfu get error")
+	}
+	if stats := cache.Stats(); stats.Hits != 5 || stats.Misses != 1 || stats.Evictions != 3 {
+		t.Fatal("lfu stats error")
+	}
+	if cache.Remove("a").OrPanic() != 1 || cache.Count() != 1 {
+		t.Fatal("lfu remove error")
+	}
+	cache.Add("f", 6)
+	cache.Add("g", 7)
+	if evicted != "bcde" {
+		t.Fatal("lfu evict after remove error")
+	}
+	cache.Clear()
+	if cache.Count() != 0 {
+		t.Fatal("lfu clear error")
+	}
+}
+
+func TestTTL(t *testing.T) {
+	var clock = &fakeClock{time.Unix(0, 0)}
+	var cache = MakeTTL[string, int](2, time.Minute, clock)
+	var evicted = ""
+	cache.OnEvict(func(k string, v int) {
+		evicted += k
+	})
+	cache.Add("a", 1)
+	clock.Advance(30 * time.Second)
+	cache.Add("b", 2)
+	if cache.Get("a").OrPanic() != 1 || cache.Count() != 2 {
+		t.Fatal("ttl get error")
+	}
+	clock.Advance(30 * time.Second)
+	if cache.Get("a").IsSome() || evicted != "a" {
+		t.Fatal("ttl expire error")
+	}
+	cache.Add("b", 3)
+	clock.Advance(45 * time.Second)
+	if cache.Peek("b").OrPanic() != 3 {
+		t.Fatal("ttl renew error")
+	}
+	cache.Add("c", 4)
+	cache.Add("d", 5)
+	if evicted != "ab" || cache.Count() != 2 {
+		t.Fatal("ttl capacity error")
+	}
+	clock.Advance(time.Hour)
+	if cache.Count() != 0 || evicted != "abcd" {
+		t.Fatal("ttl expire all error")
+	}
+	if stats := cache.Stats(); stats.Hits != 1 || stats.Misses != 1 || stats.Evictions != 4 {
+		t.Fatal("ttl stats error")
+	}
+	cache.Add("e", 6)
+	clock.Advance(time.Minute)
+	var stats = cache.Stats()
+	if cache.Peek("e").IsSome() || cache.Peek("f").IsSome() || cache.Stats() != stats || evicted != "abcd" {
+		t.Fatal("ttl peek stats error")
+	}
+}
+
+func TestSynchronized(t *testing.T) {
+	var cache = Synchronized[int, int](MakeLRU[int, int](10))
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				cache.Add(i%20, i)
+				cache.Get(i % 20)
+				cache.Peek(w)
+			}
+		}(w)
+	}
+	wg.Wait()
+	if cache.Count() != 10 || cache.Capacity() != 10 {
+		t.Fatal("synchronized cache count error")
+	}
+	if stats := cache.Stats(); stats.Hits+stats.Misses != 400 {
+		t.Fatal("synchronized cache stats error")
+	}
+}
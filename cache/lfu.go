@@ -0,0 +1,151 @@
+package cache
+
+import (
+	"github.com/kulics/gollection/dict"
+	list "github.com/kulics/gollection/linkedlist"
+	"github.com/kulics/gollection/option"
+)
+
+// Constructing an empty LFU with capacity.
+func MakeLFU[K comparable, V any](capacity int) *LFU[K, V] {
+	if capacity < 1 {
+		panic(invalidCapacity)
+	}
+	return &LFU[K, V]{
+		entries:     dict.Make[K, *list.LinkedListNode[lfuEntry[K, V]]](capacity),
+		frequencies: dict.Make[int, *list.List[lfuEntry[K, V]]](0),
+		capacity:    capacity,
+	}
+}
+
+// LFU evicts the least frequently used entry when it is full,
+// the least recently used one is evicted among the entries with the same frequency.
+// The entries are kept in linked lists for each frequency, so all operations are in constant time.
+type LFU[K comparable, V any] struct {
+	entries      *dict.Dict[K, *list.LinkedListNode[lfuEntry[K, V]]]
+	frequencies  *dict.Dict[int, *list.List[lfuEntry[K, V]]]
+	minFrequency int
+	capacity     int
+	onEvict      func(K, V)
+	stats        Stats
+}
+
+type lfuEntry[K comparable, V any] struct {
+	key       K
+	value     V
+	frequency int
+}
+
+// Set the callback that is called with the evicted entry.
+func (a *LFU[K, V]) OnEvict(callback func(key K, value V)) {
+	a.onEvict = callback
+}
+
+// Return the value of the key and increase its frequency.
+func (a *LFU[K, V]) Get(key K) option.Option[V] {
+	if node, ok := a.entries.At(key).Val(); ok {
+		a.stats.Hits++
+		return option.Some(a.touch(node).Value.value)
+	}
+	a.stats.Misses++
+	return option.None[V]()
+}
+
+// Return the value of the key without changing its frequency.
+func (a *LFU[K, V]) Peek(key K) option.Option[V] {
+	if node, ok := a.entries.At(key).Val(); ok {
+		return option.Some(node.Value.value)
+	}
+	return option.None[V]()
+}
+
+// Add the value of the key and increase its frequency,
+// evict the least frequently used entry when the cache is full.
+func (a *LFU[K, V]) Add(key K, value V) {
+	if node, ok := a.entries.At(key).Val(); ok {
+		node.Value.value = value
+		a.touch(node)
+		return
+	}
+	if a.entries.Count() >= a.capacity {
+		a.evict()
+	}
+	a.entries.Add(key, a.link(lfuEntry[K, V]{key, value, 1}))
+	a.minFrequency = 1
+}
+
+// Remove the key, return the removed value when the key has been included.
+func (a *LFU[K, V]) Remove(key K) option.Option[V] {
+	if node, ok := a.entries.Remove(key).Val(); ok {
+		return option.Some(a.unlink(node).value)
+	}
+	return option.None[V]()
+}
+
+// Returns true if the key is included in the cache, it does not change the frequency.
+func (a *LFU[K, V]) Contains(key K) bool {
+	return a.entries.Contains(key)
+}
+
+// Return the number of entries of cache.
+func (a *LFU[K, V]) Count() int {
+	return a.entries.Count()
+}
+
+// Return the maximum number of entries of cache.
+func (a *LFU[K, V]) Capacity() int {
+	return a.capacity
+}
+
+// Clears all entries, the eviction callback is not called.
+func (a *LFU[K, V]) Clear() {
+	a.entries.Clear()
+	a.frequencies.Clear()
+	a.minFrequency = 0
+}
+
+// Return the statistics of cache.
+func (a *LFU[K, V]) Stats() Stats {
+	return a.stats
+}
+
+// Move the entry to the list of the next frequency, return the new node.
+func (a *LFU[K, V]) touch(node *list.LinkedListNode[lfuEntry[K, V]]) *list.LinkedListNode[lfuEntry[K, V]] {
+	var entry = a.unlink(node)
+	if entry.frequency == a.minFrequency && !a.frequencies.Contains(entry.frequency) {
+		a.minFrequency++
+	}
+	entry.frequency++
+	var newNode = a.link(entry)
+	a.entries.Add(entry.key, newNode)
+	return newNode
+}
+
+func (a *LFU[K, V]) link(entry lfuEntry[K, V]) *list.LinkedListNode[lfuEntry[K, V]] {
+	var nodes = a.frequencies.GetOrAddWith(entry.frequency, func() *list.List[lfuEntry[K, V]] {
+		return list.Of[lfuEntry[K, V]]()
+	}).Get()
+	nodes.AddLast(entry)
+	return nodes.Back()
+}
+
+func (a *LFU[K, V]) unlink(node *list.LinkedListNode[lfuEntry[K, V]]) lfuEntry[K, V] {
+	var frequency = node.Value.frequency
+	var nodes = a.frequencies.At(frequency).Get()
+	var entry = nodes.Remove(node)
+	if nodes.Count() == 0 {
+		a.frequencies.Remove(frequency)
+	}
+	return entry
+}
+
+func (a *LFU[K, V]) evict() {
+	if nodes, ok := a.frequencies.At(a.minFrequency).Val(); ok {
+		var entry = a.unlink(nodes.Front())
+		a.entries.Remove(entry.key)
+		a.stats.Evictions++
+		if a.onEvict != nil {
+			a.onEvict(entry.key, entry.value)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package cache
+
+import (
+	"github.com/kulics/gollection/dict"
+	"github.com/kulics/gollection/option"
+)
+
+// Constructing an empty LRU with capacity.
+func MakeLRU[K comparable, V any](capacity int) *LRU[K, V] {
+	if capacity < 1 {
+		panic(invalidCapacity)
+	}
+	return &LRU[K, V]{entries: dict.MakeLinked[K, V](capacity), capacity: capacity}
+}
+
+// LRU evicts the least recently used entry when it is full.
+// The entries are kept in a LinkedDict from the least to the most recently used.
+type LRU[K comparable, V any] struct {
+	entries  *dict.LinkedDict[K, V]
+	capacity int
+	onEvict  func(K, V)
+	stats    Stats
+}
+
+// Set the callback that is called with the evicted entry.
+func (a *LRU[K, V]) OnEvict(callback func(key K, value V)) {
+	a.onEvict = callback
+}
+
+// Return the value of the key and mark it as the most recently used.
+func (a *LRU[K, V]) Get(key K) option.Option[V] {
+	if v, ok := a.entries.At(key).Val(); ok {
+		a.entries.MoveToBack(key)
+		a.stats.Hits++
+		return option.Some(v)
+	}
+	a.stats.Misses++
+	return option.None[V]()
+}
+
+// Return the value of the key without changing the recency.
+func (a *LRU[K, V]) Peek(key K) option.Option[V] {
+	if v, ok := a.entries.At(key).Val(); ok {
+		return option.Some(v)
+	}
+	return option.None[V]()
+}
+
+// Add the value of the key and mark it as the most recently used,
+// evict the least recently used entry when the cache is full.
+func (a *LRU[K, V]) Add(key K, value V) {
+	if a.entries.Add(key, value).IsSome() {
+		a.entries.MoveToBack(key)
+		return
+	}
+	if a.entries.Count() > a.capacity {
+		a.evict()
+	}
+}
+
+// Remove the key, return the removed value when the key has been included.
+func (a *LRU[K, V]) Remove(key K) option.Option[V] {
+	return a.entries.Remove(key)
+}
+
+// Returns true if the key is included in the cache, it does not change the recency.
+func (a *LRU[K, V]) Contains(key K) bool {
+	return a.entries.Contains(key)
+}
+
+// Return the number of entries of cache.
+func (a *LRU[K, V]) Count() int {
+	return a.entries.Count()
+}
+
+// Return the maximum number of entries of cache.
+func (a *LRU[K, V]) Capacity() int {
+	return a.capacity
+}
+
+// Clears all entries, the eviction callback is not called.
+func (a *LRU[K, V]) Clear() {
+	a.entries.Clear()
+}
+
+// Return the statistics of cache.
+func (a *LRU[K, V]) Stats() Stats {
+	return a.stats
+}
+
+func (a *LRU[K, V]) evict() {
+	if entry, ok := a.entries.RemoveFirst().Val(); ok {
+		a.stats.Evictions++
+		if a.onEvict != nil {
+			a.onEvict(entry.Key, entry.Value)
+		}
+	}
+}
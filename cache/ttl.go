@@ -0,0 +1,130 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/kulics/gollection/dict"
+	"github.com/kulics/gollection/option"
+)
+
+// Constructing an empty TTL with capacity, the entries expire after ttl since they are added.
+// If capacity is less than 1, the number of entries is not limited.
+func MakeTTL[K comparable, V any](capacity int, ttl time.Duration, clock Clock) *TTL[K, V] {
+	return &TTL[K, V]{
+		entries:  dict.MakeLinked[K, ttlEntry[V]](capacity),
+		capacity: capacity,
+		ttl:      ttl,
+		clock:    clock,
+	}
+}
+
+// TTL removes the entries that live longer than the ttl,
+// and evicts the earliest added entry when it is full.
+// The entries are kept in a LinkedDict in the order of expiration.
+type TTL[K comparable, V any] struct {
+	entries  *dict.LinkedDict[K, ttlEntry[V]]
+	capacity int
+	ttl      time.Duration
+	clock    Clock
+	onEvict  func(K, V)
+	stats    Stats
+}
+
+type ttlEntry[V any] struct {
+	value    V
+	expireAt time.Time
+}
+
+// Set the callback that is called with the evicted or expired entry.
+func (a *TTL[K, V]) OnEvict(callback func(key K, value V)) {
+	a.onEvict = callback
+}
+
+// Return the value of the key if it is not expired.
+func (a *TTL[K, V]) Get(key K) option.Option[V] {
+	a.expire()
+	if v, ok := a.entries.At(key).Val(); ok {
+		a.stats.Hits++
+		return option.Some(v.value)
+	}
+	a.stats.Misses++
+	return option.None[V]()
+}
+
+// Return the value of the key if it is not expired, the statistics are not changed and no entry is evicted.
+func (a *TTL[K, V]) Peek(key K) option.Option[V] {
+	if v, ok := a.entries.At(key).Val(); ok && a.clock.Now().Before(v.expireAt) {
+		return option.Some(v.value)
+	}
+	return option.None[V]()
+}
+
+// Add the value of the key, the expiration of the key is renewed.
+// Evict the earliest added entry when the cache is full.
+func (a *TTL[K, V]) Add(key K, value V) {
+	a.expire()
+	var entry = ttlEntry[V]{value, a.clock.Now().Add(a.ttl)}
+	if a.entries.Add(key, entry).IsSome() {
+		a.entries.MoveToBack(key)
+		return
+	}
+	if a.capacity > 0 && a.entries.Count() > a.capacity {
+		a.evict()
+	}
+}
+
+// Remove the key, return the removed value when the key has been included and not expired.
+func (a *TTL[K, V]) Remove(key K) option.Option[V] {
+	a.expire()
+	if v, ok := a.entries.Remove(key).Val(); ok {
+		return option.Some(v.value)
+	}
+	return option.None[V]()
+}
+
+// Returns true if the key is included in the cache and not expired.
+func (a *TTL[K, V]) Contains(key K) bool {
+	a.expire()
+	return a.entries.Contains(key)
+}
+
+// Return the number of entries that are not expired.
+func (a *TTL[K, V]) Count() int {
+	a.expire()
+	return a.entries.Count()
+}
+
+// Return the maximum number of entries of cache, it is less than 1 when the number is not limited.
+func (a *TTL[K, V]) Capacity() int {
+	return a.capacity
+}
+
+// Clears all entries, the eviction callback is not called.
+func (a *TTL[K, V]) Clear() {
+	a.entries.Clear()
+}
+
+// Return the statistics of cache, the expired entries are counted as evictions.
+func (a *TTL[K, V]) Stats() Stats {
+	return a.stats
+}
+
+func (a *TTL[K, V]) expire() {
+	var now = a.clock.Now()
+	for {
+		if entry, ok := a.entries.FirstEntry().Val(); ok && !now.Before(entry.Value.expireAt) {
+			a.evict()
+		} else {
+			break
+		}
+	}
+}
+
+func (a *TTL[K, V]) evict() {
+	if entry, ok := a.entries.RemoveFirst().Val(); ok {
+		a.stats.Evictions++
+		if a.onEvict != nil {
+			a.onEvict(entry.Key, entry.Value.value)
+		}
+	}
+}
@@ -0,0 +1,288 @@
+package collectors
+
+import (
+	"strings"
+
+	"github.com/kulics/gollection/dict"
+	"github.com/kulics/gollection/option"
+	"github.com/kulics/gollection/seq"
+	"golang.org/x/exp/constraints"
+)
+
+// Return a Collector that groups the elements by key, the elements of each group are collected by downstream.
+func GroupingBy[T any, K comparable, S any, R any](key func(T) K, downstream seq.Collector[S, T, R]) seq.Collector[*dict.Dict[K, S], T, *dict.Dict[K, R]] {
+	return groupingCollector[T, K, S, R]{key, downstream}
+}
+
+type groupingCollector[T any, K comparable, S any, R any] struct {
+	key        func(T) K
+	downstream seq.Collector[S, T, R]
+}
+
+func (a groupingCollector[T, K, S, R]) Builder() *dict.Dict[K, S] {
+	return dict.Make[K, S](10)
+}
+
+func (a groupingCollector[T, K, S, R]) Append(builder *dict.Dict[K, S], element T) {
+	var key = a.key(element)
+	if group, ok := builder.At(key).Val(); ok {
+		a.downstream.Append(group, element)
+		return
+	}
+	var group = a.downstream.Builder()
+	a.downstream.Append(group, element)
+	builder.Add(key, group)
+}
+
+func (a groupingCollector[T, K, S, R]) Finish(builder *dict.Dict[K, S]) *dict.Dict[K, R] {
+	var result = dict.Make[K, R](builder.Count())
+	seq.ForEach[dict.Entry[K, S]](func(t dict.Entry[K, S]) {
+		result.Add(t.Key, a.downstream.Finish(t.Value))
+	}, builder)
+	return result
+}
+
+// Return a Collector that splits the elements by predicate, the elements of each part are collected by downstream.
+// The result always contains both true and false.
+func PartitioningBy[T any, S any, R any](predicate func(T) bool, downstream seq.Collector[S, T, R]) seq.Collector[seq.Pair[S, S], T, *dict.Dict[bool, R]] {
+	return partitioningCollector[T, S, R]{predicate, downstream}
+}
+
+type partitioningCollector[T any, S any, R any] struct {
+	predicate  func(T) bool
+	downstream seq.Collector[S, T, R]
+}
+
+func (a partitioningCollector[T, S, R]) Builder() seq.Pair[S, S] {
+	return seq.Pair[S, S]{First: a.downstream.Builder(), Second: a.downstream.Builder()}
+}
+
+func (a partitioningCollector[T, S, R]) Append(builder seq.Pair[S, S], element T) {
+	if a.predicate(element) {
+		a.downstream.Append(builder.First, element)
+	} else {
+		a.downstream.Append(builder.Second, element)
+	}
+}
+
+func (a partitioningCollector[T, S, R]) Finish(builder seq.Pair[S, S]) *dict.Dict[bool, R] {
+	var result = dict.Make[bool, R](2)
+	result.Add(true, a.downstream.Finish(builder.First))
+	result.Add(false, a.downstream.Finish(builder.Second))
+	return result
+}
+
+// Return a Collector that collects the elements into a Dict by key and value,
+// the values of the same key are combined by merge, or the later value replaces the earlier one when merge is nil.
+func ToDictBy[T any, K comparable, V any](key func(T) K, value func(T) V, merge func(V, V) V) seq.Collector[*dict.Dict[K, V], T, *dict.Dict[K, V]] {
+	return toDictCollector[T, K, V]{key, value, merge}
+}
+
+type toDictCollector[T any, K comparable, V any] struct {
+	key   func(T) K
+	value func(T) V
+	merge func(V, V) V
+}
+
+func (a toDictCollector[T, K, V]) Builder() *dict.Dict[K, V] {
+	return dict.Make[K, V](10)
+}
+
+func (a toDictCollector[T, K, V]) Append(builder *dict.Dict[K, V], element T) {
+	var key, value = a.key(element), a.value(element)
+	if a.merge != nil {
+		if old := builder.At(key); old.IsNotNil() {
+			old.Set(a.merge(old.Get(), value))
+			return
+		}
+	}
+	builder.Add(key, value)
+}
+
+func (a toDictCollector[T, K, V]) Finish(builder *dict.Dict[K, V]) *dict.Dict[K, V] {
+	return builder
+}
+
+// Return a Collector that counts the elements.
+func Counting[T any]() seq.Collector[*int, T, int] {
+	return countingCollector[T]{}
+}
+
+type countingCollector[T any] struct{}
+
+func (a countingCollector[T]) Builder() *int {
+	return new(int)
+}
+
+func (a countingCollector[T]) Append(builder *int, element T) {
+	*builder++
+}
+
+func (a countingCollector[T]) Finish(builder *int) int {
+	return *builder
+}
+
+// Return a Collector that sums the elements.
+func Summing[T constraints.Integer | constraints.Float]() seq.Collector[*T, T, T] {
+	return summingCollector[T]{}
+}
+
+type summingCollector[T constraints.Integer | constraints.Float] struct{}
+
+func (a summingCollector[T]) Builder() *T {
+	return new(T)
+}
+
+func (a summingCollector[T]) Append(builder *T, element T) {
+	*builder += element
+}
+
+func (a summingCollector[T]) Finish(builder *T) T {
+	return *builder
+}
+
+// Return a Collector that averages the elements, the average of no element is 0.
+func Averaging[T constraints.Integer | constraints.Float]() seq.Collector[*seq.Pair[float64, int], T, float64] {
+	return averagingCollector[T]{}
+}
+
+type averagingCollector[T constraints.Integer | constraints.Float] struct{}
+
+func (a averagingCollector[T]) Builder() *seq.Pair[float64, int] {
+	return &seq.Pair[float64, int]{}
+}
+
+func (a averagingCollector[T]) Append(builder *seq.Pair[float64, int], element T) {
+	builder.First += float64(element)
+	builder.Second++
+}
+
+func (a averagingCollector[T]) Finish(builder *seq.Pair[float64, int]) float64 {
+	if builder.Second == 0 {
+		return 0
+	}
+	return builder.First / float64(builder.Second)
+}
+
+// Return a Collector that concatenates the strings with separator.
+func Joining(separator string) seq.Collector[*[]string, string, string] {
+	return joiningCollector{separator}
+}
+
+type joiningCollector struct {
+	separator string
+}
+
+func (a joiningCollector) Builder() *[]string {
+	var builder = make([]string, 0)
+	return &builder
+}
+
+func (a joiningCollector) Append(builder *[]string, element string) {
+	*builder = append(*builder, element)
+}
+
+func (a joiningCollector) Finish(builder *[]string) string {
+	return strings.Join(*builder, a.separator)
+}
+
+// Return a Collector that finds the minimum element by less, the first one is kept among the equal minimums.
+// Return None when there is no element.
+func MinBy[T any](less func(T, T) bool) seq.Collector[*option.Option[T], T, option.Option[T]] {
+	return extremumCollector[T]{less}
+}
+
+// Return a Collector that finds the maximum element by greater, the first one is kept among the equal maximums.
+// Return None when there is no element.
+func MaxBy[T any](greater func(T, T) bool) seq.Collector[*option.Option[T], T, option.Option[T]] {
+	return extremumCollector[T]{greater}
+}
+
+type extremumCollector[T any] struct {
+	better func(T, T) bool
+}
+
+func (a extremumCollector[T]) Builder() *option.Option[T] {
+	var builder = option.None[T]()
+	return &builder
+}
+
+func (a extremumCollector[T]) Append(builder *option.Option[T], element T) {
+	if v, ok := builder.Val(); !ok || a.better(element, v) {
+		*builder = option.Some(element)
+	}
+}
+
+func (a extremumCollector[T]) Finish(builder *option.Option[T]) option.Option[T] {
+	return *builder
+}
+
+// Return a Collector that maps the elements by mapper before collecting them by downstream.
+func Mapping[T any, U any, S any, R any](mapper func(T) U, downstream seq.Collector[S, U, R]) seq.Collector[S, T, R] {
+	return mappingCollector[T, U, S, R]{mapper, downstream}
+}
+
+type mappingCollector[T any, U any, S any, R any] struct {
+	mapper     func(T) U
+	downstream seq.Collector[S, U, R]
+}
+
+func (a mappingCollector[T, U, S, R]) Builder() S {
+	return a.downstream.Builder()
+}
+
+func (a mappingCollector[T, U, S, R]) Append(builder S, element T) {
+	a.downstream.Append(builder, a.mapper(element))
+}
+
+func (a mappingCollector[T, U, S, R]) Finish(builder S) R {
+	return a.downstream.Finish(builder)
+}
+
+// Return a Collector that only collects the elements matching predicate by downstream.
+func Filtering[T any, S any, R any](predicate func(T) bool, downstream seq.Collector[S, T, R]) seq.Collector[S, T, R] {
+	return filteringCollector[T, S, R]{predicate, downstream}
+}
+
+type filteringCollector[T any, S any, R any] struct {
+	predicate  func(T) bool
+	downstream seq.Collector[S, T, R]
+}
+
+func (a filteringCollector[T, S, R]) Builder() S {
+	return a.downstream.Builder()
+}
+
+func (a filteringCollector[T, S, R]) Append(builder S, element T) {
+	if a.predicate(element) {
+		a.downstream.Append(builder, element)
+	}
+}
+
+func (a filteringCollector[T, S, R]) Finish(builder S) R {
+	return a.downstream.Finish(builder)
+}
+
+// Return a Collector that collects the elements by both collectors, and combines their results by merger.
+func Teeing[T any, S1 any, R1 any, S2 any, R2 any, R any](first seq.Collector[S1, T, R1], second seq.Collector[S2, T, R2], merger func(R1, R2) R) seq.Collector[seq.Pair[S1, S2], T, R] {
+	return teeingCollector[T, S1, R1, S2, R2, R]{first, second, merger}
+}
+
+type teeingCollector[T any, S1 any, R1 any, S2 any, R2 any, R any] struct {
+	first  seq.Collector[S1, T, R1]
+	second seq.Collector[S2, T, R2]
+	merger func(R1, R2) R
+}
+
+func (a teeingCollector[T, S1, R1, S2, R2, R]) Builder() seq.Pair[S1, S2] {
+	return seq.Pair[S1, S2]{First: a.first.Builder(), Second: a.second.Builder()}
+}
+
+func (a teeingCollector[T, S1, R1, S2, R2, R]) Append(builder seq.Pair[S1, S2], element T) {
+	a.first.Append(builder.First, element)
+	a.second.Append(builder.Second, element)
+}
+
+func (a teeingCollector[T, S1, R1, S2, R2, R]) Finish(builder seq.Pair[S1, S2]) R {
+	return a.merger(a.first.Finish(builder.First), a.second.Finish(builder.Second))
+}
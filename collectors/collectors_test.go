@@ -0,0 +1,99 @@
+package collectors
+
+import (
+	"testing"
+
+	"github.com/kulics/gollection/list"
+	"github.com/kulics/gollection/option"
+	"github.com/kulics/gollection/seq"
+)
+
+var datas seq.Sequence[string] = seq.Slice[string]{"apple", "bean", "avocado", "corn", "banana"}
+
+var first = func(s string) byte {
+	return s[0]
+}
+
+var length = func(s string) int {
+	return len(s)
+}
+
+func TestGroupingBy(t *testing.T) {
+	var groups = seq.Collect(GroupingBy(first, list.Collector[string]()), datas)
+	if groups.Count() != 3 {
+		t.Fatal("GroupingBy count error")
+	}
+	if b := groups.At('b').Get(); b.Count() != 2 || b.At(0).Get() != "bean" || b.At(1).Get() != "banana" {
+		t.Fatal("GroupingBy order error")
+	}
+	var counts = seq.Collect(GroupingBy(first, Counting[string]()), datas)
+	if counts.At('a').Get() != 2 || counts.At('c').Get() != 1 {
+		t.Fatal("GroupingBy Counting error")
+	}
+	var lengths = seq.Collect(GroupingBy(first, Mapping(length, Summing[int]())), datas)
+	if lengths.At('a').Get() != 12 || lengths.At('b').Get() != 10 {
+		t.Fatal("GroupingBy Mapping error")
+	}
+	if seq.Collect(GroupingBy(first, Counting[string]()), seq.Sequence[string](seq.Slice[string]{})).Count() != 0 {
+		t.Fatal("GroupingBy empty error")
+	}
+}
+
+func TestPartitioningBy(t *testing.T) {
+	var short = func(s string) bool { return len(s) < 5 }
+	var parts = seq.Collect(PartitioningBy(short, Joining(",")), datas)
+	if parts.At(true).Get() != "bean,corn" || parts.At(false).Get() != "apple,avocado,banana" {
+		t.Fatal("PartitioningBy error")
+	}
+	var empty = seq.Collect(PartitioningBy(short, Counting[string]()), seq.Sequence[string](seq.Slice[string]{}))
+	if empty.Count() != 2 || empty.At(true).Get() != 0 || empty.At(false).Get() != 0 {
+		t.Fatal("PartitioningBy empty error")
+	}
+}
+
+func TestToDictBy(t *testing.T) {
+	var concat = func(l, r string) string { return l + "," + r }
+	var merged = seq.Collect(ToDictBy(first, func(s string) string { return s }, concat), datas)
+	if merged.Count() != 3 || merged.At('a').Get() != "apple,avocado" {
+		t.Fatal("ToDictBy merge error")
+	}
+	var replaced = seq.Collect(ToDictBy[string, byte, string](first, func(s string) string { return s }, nil), datas)
+	if replaced.At('b').Get() != "banana" {
+		t.Fatal("ToDictBy replace error")
+	}
+}
+
+func TestAggregating(t *testing.T) {
+	var numbers seq.Sequence[int] = seq.Slice[int]{3, 1, 4, 1, 5}
+	if seq.Collect(Counting[int](), numbers) != 5 || seq.Collect(Summing[int](), numbers) != 14 {
+		t.Fatal("Counting Summing error")
+	}
+	if seq.Collect(Averaging[int](), numbers) != 2.8 || seq.Collect(Averaging[int](), seq.Sequence[int](seq.Slice[int]{})) != 0 {
+		t.Fatal("Averaging error")
+	}
+	if seq.Collect(Joining(", "), datas) != "apple, bean, avocado, corn, banana" || seq.Collect(Joining(","), seq.Sequence[string](seq.Slice[string]{})) != "" {
+		t.Fatal("Joining error")
+	}
+	var shorter = func(l, r string) bool { return len(l) < len(r) }
+	var longer = func(l, r string) bool { return len(l) > len(r) }
+	if seq.Collect(MinBy(shorter), datas).OrPanic() != "bean" || seq.Collect(MaxBy(longer), datas).OrPanic() != "avocado" {
+		t.Fatal("MinBy MaxBy error")
+	}
+	if seq.Collect(MinBy(shorter), seq.Sequence[string](seq.Slice[string]{})).IsSome() {
+		t.Fatal("MinBy empty error")
+	}
+}
+
+func TestTeeing(t *testing.T) {
+	var short = func(s string) bool { return len(s) < 5 }
+	var ratio = func(part, total int) float64 { return float64(part) / float64(total) }
+	if seq.Collect(Teeing(Filtering(short, Counting[string]()), Counting[string](), ratio), datas) != 0.4 {
+		t.Fatal("Teeing error")
+	}
+	var rangeOf = func(min, max option.Option[int]) int { return max.OrPanic() - min.OrPanic() }
+	var less = func(l, r int) bool { return l < r }
+	var greater = func(l, r int) bool { return l > r }
+	if seq.Collect(Teeing(MinBy(less), MaxBy(greater), rangeOf), seq.Sequence[int](seq.Slice[int]{3, 9, 2})) != 7 {
+		t.Fatal("Teeing range error")
+	}
+}
@@ -0,0 +1,208 @@
+package concurrent
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/kulics/gollection/dict"
+	"github.com/kulics/gollection/option"
+	"github.com/kulics/gollection/seq"
+)
+
+func shardsLengthFor(length int) int {
+	if length < 1 {
+		length = runtime.GOMAXPROCS(0) * 4
+	}
+	var shardsLength = 1
+	for shardsLength < length {
+		shardsLength = shardsLength * 2
+	}
+	return shardsLength
+}
+
+// Constructing a ConcurrentDict with variable-length parameters.
+func Of[K comparable, V any](elements ...dict.Entry[K, V]) *ConcurrentDict[K, V] {
+	var d = Make[K, V](0)
+	for _, v := range elements {
+		d.Add(v.Key, v.Value)
+	}
+	return d
+}
+
+// Constructing an empty ConcurrentDict with the number of shards.
+// If shards is less than 1, it is decided by GOMAXPROCS.
+func Make[K comparable, V any](shards int) *ConcurrentDict[K, V] {
+	return MakeWithHasher[K, V](dict.DefaultHasher[K](), shards)
+}
+
+// Constructing an empty ConcurrentDict with hasher and the number of shards.
+// The hasher must be safe for concurrent use.
+func MakeWithHasher[K comparable, V any](hasher func(K) uint64, shards int) *ConcurrentDict[K, V] {
+	var length = shardsLengthFor(shards)
+	var d = &ConcurrentDict[K, V]{
+		shards: make([]shard[K, V], length),
+		hash:   hasher,
+	}
+	for i := range d.shards {
+		d.shards[i].dict = dict.MakeWithHasher[K, V](hasher, 0)
+	}
+	return d
+}
+
+// ConcurrentDict is safe for concurrent use, the entries are split into shards of dict.Dict,
+// each shard is guarded by its own lock, so the operations on different shards do not block each other.
+type ConcurrentDict[K comparable, V any] struct {
+	shards []shard[K, V]
+	hash   func(K) uint64
+}
+
+type shard[K comparable, V any] struct {
+	sync.RWMutex
+	dict *dict.Dict[K, V]
+}
+
+// Return the number of entries of dict.
+// It is only a snapshot when the dict is being modified.
+func (a *ConcurrentDict[K, V]) Count() int {
+	var count = 0
+	for i := range a.shards {
+		var s = &a.shards[i]
+		s.RLock()
+		count += s.dict.Count()
+		s.RUnlock()
+	}
+	return count
+}
+
+// Returns true if the key is included in the dict.
+func (a *ConcurrentDict[K, V]) Contains(key K) bool {
+	var s = a.shardOf(key)
+	s.RLock()
+	defer s.RUnlock()
+	return s.dict.Contains(key)
+}
+
+// Return a copy of the value of the key.
+// Return None when the key is not included.
+func (a *ConcurrentDict[K, V]) At(key K) option.Option[V] {
+	var s = a.shardOf(key)
+	s.RLock()
+	defer s.RUnlock()
+	if v, ok := s.dict.At(key).Val(); ok {
+		return option.Some(v)
+	}
+	return option.None[V]()
+}
+
+// Add the value of the key, return the old value when the key has been included.
+func (a *ConcurrentDict[K, V]) Add(key K, value V) option.Option[V] {
+	var s = a.shardOf(key)
+	s.Lock()
+	defer s.Unlock()
+	return s.dict.Add(key, value)
+}
+
+// Remove the key, return the removed value when the key has been included.
+func (a *ConcurrentDict[K, V]) Remove(key K) option.Option[V] {
+	var s = a.shardOf(key)
+	s.Lock()
+	defer s.Unlock()
+	return s.dict.Remove(key)
+}
+
+// Return the value of the key, or add the value when the key is not included and return it, atomically.
+func (a *ConcurrentDict[K, V]) GetOrAdd(key K, value V) V {
+	var s = a.shardOf(key)
+	s.Lock()
+	defer s.Unlock()
+	return s.dict.GetOrAdd(key, value).Get()
+}
+
+// Add the value when the key is not included, atomically.
+// Returns true if the value has been added.
+func (a *ConcurrentDict[K, V]) AddIfAbsent(key K, value V) bool {
+	var s = a.shardOf(key)
+	s.Lock()
+	defer s.Unlock()
+	var added = false
+	s.dict.GetOrAddWith(key, func() V {
+		added = true
+		return value
+	})
+	return added
+}
+
+// Compute the new value of the key from the old value atomically, the old value is None when the key is not included.
+// The key is removed when the new value is None. Return the new value.
+// The remapping is called with the lock of shard held, so it must not access the dict.
+func (a *ConcurrentDict[K, V]) Compute(key K, remapping func(old option.Option[V]) option.Option[V]) option.Option[V] {
+	var s = a.shardOf(key)
+	s.Lock()
+	defer s.Unlock()
+	return s.dict.Compute(key, remapping)
+}
+
+// Clears all entries, the shards are cleared one by one.
+func (a *ConcurrentDict[K, V]) Clear() {
+	for i := range a.shards {
+		var s = &a.shards[i]
+		s.Lock()
+		s.dict.Clear()
+		s.Unlock()
+	}
+}
+
+// Return the Iterator of dict.
+// The iteration is weakly consistent, each shard is copied when the iteration reaches it,
+// so it never blocks writers for longer than copying one shard, and it may or may not reflect
+// the modifications after the iteration begins.
+func (a *ConcurrentDict[K, V]) Iterator() seq.Iterator[dict.Entry[K, V]] {
+	return &iterator[K, V]{a, -1, nil, 0}
+}
+
+func (a *ConcurrentDict[K, V]) shardOf(key K) *shard[K, V] {
+	// The high bits are used, the low bits are used by the buckets of shard.
+	return &a.shards[int((a.hash(key)>>32)%uint64(len(a.shards)))]
+}
+
+type iterator[K comparable, V any] struct {
+	source   *ConcurrentDict[K, V]
+	shard    int
+	snapshot []dict.Entry[K, V]
+	index    int
+}
+
+func (a *iterator[K, V]) Next() option.Option[dict.Entry[K, V]] {
+	for a.index >= len(a.snapshot) {
+		if a.shard >= len(a.source.shards)-1 {
+			return option.None[dict.Entry[K, V]]()
+		}
+		a.shard++
+		var s = &a.source.shards[a.shard]
+		s.RLock()
+		a.snapshot = seq.ToSlice[dict.Entry[K, V]](s.dict)
+		s.RUnlock()
+		a.index = 0
+	}
+	var item = a.snapshot[a.index]
+	a.index++
+	return option.Some(item)
+}
+
+func Collector[K comparable, V any]() seq.Collector[*ConcurrentDict[K, V], dict.Entry[K, V], *ConcurrentDict[K, V]] {
+	return collector[K, V]{}
+}
+
+type collector[K comparable, V any] struct{}
+
+func (a collector[K, V]) Builder() *ConcurrentDict[K, V] {
+	return Make[K, V](0)
+}
+
+func (a collector[K, V]) Append(supplier *ConcurrentDict[K, V], element dict.Entry[K, V]) {
+	supplier.Add(element.Key, element.Value)
+}
+
+func (a collector[K, V]) Finish(supplier *ConcurrentDict[K, V]) *ConcurrentDict[K, V] {
+	return supplier
+}
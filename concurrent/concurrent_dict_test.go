@@ -0,0 +1,86 @@
+package concurrent
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/kulics/gollection/dict"
+	"github.com/kulics/gollection/option"
+	"github.com/kulics/gollection/seq"
+)
+
+func TestConcurrentDict(t *testing.T) {
+	var d = Of(dict.Entry[string, int]{Key: "a", Value: 1})
+	if d.Count() != 1 || d.At("a").OrPanic() != 1 {
+		t.Fatal("dict of error")
+	}
+	if d.Add("a", 2).OrPanic() != 1 || d.At("a").OrPanic() != 2 {
+		t.Fatal("dict add error")
+	}
+	if d.GetOrAdd("a", 3) != 2 || d.GetOrAdd("b", 3) != 3 {
+		t.Fatal("dict get or add error")
+	}
+	if d.AddIfAbsent("b", 4) || !d.AddIfAbsent("c", 4) {
+		t.Fatal("dict add if absent error")
+	}
+	var increment = func(old option.Option[int]) option.Option[int] {
+		return option.Some(old.Or(0) + 1)
+	}
+	if d.Compute("a", increment).OrPanic() != 3 || d.Compute("d", increment).OrPanic() != 1 {
+		t.Fatal("dict compute error")
+	}
+	d.Compute("d", func(old option.Option[int]) option.Option[int] {
+		return option.None[int]()
+	})
+	if d.Contains("d") || d.Count() != 3 {
+		t.Fatal("dict compute remove error")
+	}
+	if d.Remove("c").OrPanic() != 4 || d.Remove("c").IsSome() {
+		t.Fatal("dict remove error")
+	}
+	if seq.Count[dict.Entry[string, int]](d) != 2 {
+		t.Fatal("dict iterator count error")
+	}
+	d.Clear()
+	if d.Count() != 0 {
+		t.Fatal("dict count not eq 0")
+	}
+}
+
+func TestConcurrentDictStress(t *testing.T) {
+	var d = Make[int, int](4)
+	var wg sync.WaitGroup
+	const workers = 8
+	const operations = 1000
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < operations; i++ {
+				var key = i % 100
+				d.Compute(key, func(old option.Option[int]) option.Option[int] {
+					return option.Some(old.Or(0) + 1)
+				})
+				d.Add(1000+w*operations+i, i)
+				d.At(key)
+				if i%10 == 0 {
+					d.Remove(1000 + w*operations + i)
+				}
+				if i%100 == 0 {
+					seq.Count[dict.Entry[int, int]](d)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	var sum = 0
+	for i := 0; i < 100; i++ {
+		sum += d.At(i).OrPanic()
+	}
+	if sum != workers*operations {
+		t.Fatal("dict compute is not atomic")
+	}
+	if d.Count() != 100+workers*operations*9/10 {
+		t.Fatal("dict count error")
+	}
+}
@@ -0,0 +1,255 @@
+package deque
+
+import (
+	"github.com/kulics/gollection/option"
+	"github.com/kulics/gollection/ref"
+	"github.com/kulics/gollection/seq"
+)
+
+const defaultElementsLength = 10
+
+func arrayGrow(length int) int {
+	var newLength = length + (length >> 1)
+	if newLength < defaultElementsLength {
+		newLength = defaultElementsLength
+	}
+	return newLength
+}
+
+// Constructing a Deque with variable-length parameters
+func Of[T any](elements ...T) *Deque[T] {
+	var length = len(elements)
+	var deque = Make[T](length)
+	copy(deque.elements, elements)
+	deque.length = length
+	return deque
+}
+
+// Constructing an empty Deque with capacity.
+func Make[T any](capacity int) *Deque[T] {
+	if capacity < defaultElementsLength {
+		capacity = defaultElementsLength
+	}
+	return &Deque[T]{elements: make([]T, capacity)}
+}
+
+// Constructing a Deque from other Collection.
+func From[T any](collection seq.Collection[T]) *Deque[T] {
+	var elements = seq.ToSlice(collection)
+	return &Deque[T]{elements: elements, length: len(elements)}
+}
+
+// Deque implemented using growable ring buffer.
+// It supports adding and removing elements at both ends in amortized constant time.
+// The iterators panic with seq.ConcurrentModification when deque is modified during iteration.
+type Deque[T any] struct {
+	elements []T
+	head     int
+	length   int
+	modCount int
+}
+
+// Return the number of elements of deque.
+func (a *Deque[T]) Count() int {
+	return a.length
+}
+
+// Returns the element at the begin.
+// Return nil when the deque is empty.
+func (a *Deque[T]) First() ref.Ref[T] {
+	return a.At(0)
+}
+
+// Returns the element at the end.
+// Return nil when the deque is empty.
+func (a *Deque[T]) Last() ref.Ref[T] {
+	return a.At(a.length - 1)
+}
+
+// Return the element at the index.
+// Return nil when a subscript is out of bounds.
+func (a *Deque[T]) At(index int) ref.Ref[T] {
+	if index < 0 || index >= a.length {
+		return ref.Of[T](nil)
+	}
+	return ref.Of(&a.elements[a.physical(index)])
+}
+
+// Add element at the begin.
+func (a *Deque[T]) AddFirst(element T) {
+	if growLength := a.length + 1; len(a.elements) < growLength {
+		a.grow(growLength)
+	}
+	a.head = a.physical(len(a.elements) - 1)
+	a.elements[a.head] = element
+	a.length++
+	a.modCount++
+}
+
+// Add element at the end.
+func (a *Deque[T]) AddLast(element T) {
+	if growLength := a.length + 1; len(a.elements) < growLength {
+		a.grow(growLength)
+	}
+	a.elements[a.physical(a.length)] = element
+	a.length++
+	a.modCount++
+}
+
+// Remove element at the begin.
+// Return None when the deque is empty.
+func (a *Deque[T]) RemoveFirst() option.Option[T] {
+	if a.length == 0 {
+		return option.None[T]()
+	}
+	var removed = a.elements[a.head]
+	var emptyValue T
+	a.elements[a.head] = emptyValue
+	a.head = a.physical(1)
+	a.length--
+	a.modCount++
+	return option.Some(removed)
+}
+
+// Remove element at the end.
+// Return None when the deque is empty.
+func (a *Deque[T]) RemoveLast() option.Option[T] {
+	if a.length == 0 {
+		return option.None[T]()
+	}
+	var index = a.physical(a.length - 1)
+	var removed = a.elements[index]
+	var emptyValue T
+	a.elements[index] = emptyValue
+	a.length--
+	a.modCount++
+	return option.Some(removed)
+}
+
+// Ensure that deque have enough space before expansion.
+func (a *Deque[T]) Reserve(additional int) {
+	if addable := len(a.elements) - a.length; addable < additional {
+		a.grow(a.length + additional)
+	}
+}
+
+// Return the capacity of deque.
+func (a *Deque[T]) Capacity() int {
+	return len(a.elements)
+}
+
+// Clears all elements, but does not reset the space.
+func (a *Deque[T]) Clear() {
+	var emptyValue T
+	for i := 0; i < a.length; i++ {
+		a.elements[a.physical(i)] = emptyValue
+	}
+	a.head = 0
+	a.length = 0
+	a.modCount++
+}
+
+// Return the Iterator of deque, from the begin to the end.
+func (a *Deque[T]) Iterator() seq.Iterator[T] {
+	return &iterator[T]{-1, a, a.modCount}
+}
+
+// Return a Sequence of the elements from the end to the begin.
+func (a *Deque[T]) Reversed() seq.Sequence[T] {
+	return reversedSequence[T]{a}
+}
+
+// Return a new deque that copies all elements.
+func (a *Deque[T]) Clone() *Deque[T] {
+	var elements = make([]T, len(a.elements))
+	copy(elements, a.elements)
+	return &Deque[T]{
+		elements: elements,
+		head:     a.head,
+		length:   a.length,
+	}
+}
+
+func (a *Deque[T]) physical(index int) int {
+	var i = a.head + index
+	if i >= len(a.elements) {
+		i -= len(a.elements)
+	}
+	return i
+}
+
+func (a *Deque[T]) grow(minCapacity int) {
+	var newLength = arrayGrow(len(a.elements))
+	if newLength < minCapacity {
+		newLength = minCapacity
+	}
+	var newSource = make([]T, newLength)
+	if a.head+a.length <= len(a.elements) {
+		copy(newSource, a.elements[a.head:a.head+a.length])
+	} else {
+		var n = copy(newSource, a.elements[a.head:])
+		copy(newSource[n:], a.elements[:a.length-n])
+	}
+	a.elements = newSource
+	a.head = 0
+}
+
+type iterator[T any] struct {
+	index    int
+	source   *Deque[T]
+	modCount int
+}
+
+func (a *iterator[T]) Next() option.Option[T] {
+	if a.modCount != a.source.modCount {
+		panic(seq.ConcurrentModification)
+	}
+	if a.index < a.source.length-1 {
+		a.index++
+		return option.Some(a.source.elements[a.source.physical(a.index)])
+	}
+	return option.None[T]()
+}
+
+type reversedSequence[T any] struct {
+	source *Deque[T]
+}
+
+func (a reversedSequence[T]) Iterator() seq.Iterator[T] {
+	return &reversedIterator[T]{a.source.length, a.source, a.source.modCount}
+}
+
+type reversedIterator[T any] struct {
+	index    int
+	source   *Deque[T]
+	modCount int
+}
+
+func (a *reversedIterator[T]) Next() option.Option[T] {
+	if a.modCount != a.source.modCount {
+		panic(seq.ConcurrentModification)
+	}
+	if a.index > 0 && a.index <= a.source.length {
+		a.index--
+		return option.Some(a.source.elements[a.source.physical(a.index)])
+	}
+	return option.None[T]()
+}
+
+func Collector[T any]() seq.Collector[*Deque[T], T, *Deque[T]] {
+	return collector[T]{}
+}
+
+type collector[T any] struct{}
+
+func (a collector[T]) Builder() *Deque[T] {
+	return Make[T](10)
+}
+
+func (a collector[T]) Append(supplier *Deque[T], element T) {
+	supplier.AddLast(element)
+}
+
+func (a collector[T]) Finish(supplier *Deque[T]) *Deque[T] {
+	return supplier
+}
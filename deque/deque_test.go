@@ -0,0 +1,93 @@
+package deque
+
+import (
+	"testing"
+
+	"github.com/kulics/gollection/internal/panics"
+	"github.com/kulics/gollection/seq"
+)
+
+func TestDeque(t *testing.T) {
+	var deque = Of[int]()
+	if deque.Count() != 0 {
+		t.Fatal("deque count not eq 0")
+	}
+	if deque.Capacity() != defaultElementsLength {
+		t.Fatal("deque capacity not eq defaultElementsLength")
+	}
+	if deque.RemoveFirst().IsSome() || deque.RemoveLast().IsSome() {
+		t.Fatal("deque must has not element")
+	}
+	if deque.First().IsNotNil() || deque.Last().IsNotNil() {
+		t.Fatal("deque must has not element")
+	}
+	deque.AddLast(1)
+	deque.AddFirst(0)
+	if deque.Count() != 2 {
+		t.Fatal("deque count not eq 2")
+	}
+	if deque.First().Get() != 0 || deque.Last().Get() != 1 {
+		t.Fatal("deque first or last error")
+	}
+	if deque.At(1).Get() != 1 || deque.At(2).IsNotNil() || deque.At(-1).IsNotNil() {
+		t.Fatal("deque at error")
+	}
+	for i := 2; i <= 10; i++ {
+		deque.AddLast(i)
+	}
+	for i := -1; i >= -5; i-- {
+		deque.AddFirst(i)
+	}
+	if deque.Count() != 16 {
+		t.Fatal("deque count not eq 16")
+	}
+	if deque.Capacity() != 22 {
+		t.Fatal("deque capacity not grow")
+	}
+	for i := 0; i < deque.Count(); i++ {
+		if deque.At(i).Get() != i-5 {
+			t.Fatal("deque element error")
+		}
+	}
+	if !seq.Equals[int](seq.ToSlice[int](deque.Clone()), seq.ToSlice[int](deque)) {
+		t.Fatal("deque clone error")
+	}
+	var reversed = seq.CollectToSlice(deque.Reversed().Iterator())
+	if len(reversed) != 16 || reversed[0] != 10 || reversed[15] != -5 {
+		t.Fatal("deque reversed error")
+	}
+	if deque.RemoveFirst().OrPanic() != -5 || deque.RemoveLast().OrPanic() != 10 {
+		t.Fatal("deque remove error")
+	}
+	if deque.Count() != 14 || deque.First().Get() != -4 || deque.Last().Get() != 9 {
+		t.Fatal("deque remove error")
+	}
+	var sum = 0
+	for deque.Count() > 0 {
+		sum += deque.RemoveFirst().OrPanic()
+	}
+	if sum != 45-10 {
+		t.Fatal("deque sum error")
+	}
+	deque.Reserve(30)
+	if deque.Capacity() != 33 {
+		t.Fatal("deque capacity not grow to 33")
+	}
+	deque.AddFirst(1)
+	deque.Clear()
+	if deque.Count() != 0 || deque.Capacity() != 33 {
+		t.Fatal("deque clear error")
+	}
+	var collected = seq.Collect[int](Collector[int](), seq.Slice[int]{1, 2, 3})
+	if collected.Count() != 3 || collected.Last().Get() != 3 {
+		t.Fatal("deque collector error")
+	}
+	var iter = collected.Iterator()
+	var reversedIter = collected.Reversed().Iterator()
+	iter.Next()
+	collected.AddFirst(0)
+	if panics.Recover(func() { iter.Next() }) != seq.ConcurrentModification ||
+		panics.Recover(func() { reversedIter.Next() }) != seq.ConcurrentModification {
+		t.Fatal("fail fast error")
+	}
+}
@@ -0,0 +1,309 @@
+package dict
+
+import (
+	"testing"
+
+	"github.com/kulics/gollection/option"
+	"github.com/kulics/gollection/seq"
+)
+
+const benchmarkLength = 1 << 16
+
+// chainedDict is the separate chaining implementation that Dict used before,
+// it is kept here as the baseline of the benchmarks.
+type chainedDict[K comparable, V any] struct {
+	buckets     []int
+	entries     []chainedEntry[K, V]
+	appendCount int
+	freeCount   int
+	freeLength  int
+	hash        func(K) uint64
+}
+
+type chainedEntry[K any, V any] struct {
+	hash  uint64
+	key   K
+	value V
+	next  int
+	alive bool
+}
+
+func makeChained[K comparable, V any](hasher func(K) uint64) *chainedDict[K, V] {
+	var buckets = make([]int, 16)
+	for i := 0; i < len(buckets); i++ {
+		buckets[i] = -1
+	}
+	return &chainedDict[K, V]{buckets: buckets, entries: make([]chainedEntry[K, V], 10), hash: hasher}
+}
+
+func (a *chainedDict[K, V]) find(hash uint64, key K) (int, int) {
+	var last = -1
+	for i := a.buckets[hash%uint64(len(a.buckets))]; i >= 0; i = a.entries[i].next {
+		if item := &a.entries[i]; item.hash == hash && item.key == key {
+			return i, last
+		}
+		last = i
+	}
+	return -1, last
+}
+
+func (a *chainedDict[K, V]) At(key K) (V, bool) {
+	if i, _ := a.find(a.hash(key), key); i >= 0 {
+		return a.entries[i].value, true
+	}
+	var v V
+	return v, false
+}
+
+func (a *chainedDict[K, V]) Add(key K, value V) {
+	var hash = a.hash(key)
+	if i, _ := a.find(hash, key); i >= 0 {
+		a.entries[i].value = value
+		return
+	}
+	var i int
+	if a.freeLength > 0 {
+		i = a.freeCount
+		a.freeCount = a.entries[i].next
+		a.freeLength--
+	} else {
+		a.grow(a.appendCount - a.freeLength + 1)
+		i = a.appendCount
+		a.appendCount++
+	}
+	var bucket = hash % uint64(len(a.buckets))
+	a.entries[i] = chainedEntry[K, V]{hash, key, value, a.buckets[bucket], true}
+	a.buckets[bucket] = i
+}
+
+func (a *chainedDict[K, V]) Remove(key K) {
+	var hash = a.hash(key)
+	if i, last := a.find(hash, key); i >= 0 {
+		if last < 0 {
+			a.buckets[hash%uint64(len(a.buckets))] = a.entries[i].next
+		} else {
+			a.entries[last].next = a.entries[i].next
+		}
+		a.entries[i] = chainedEntry[K, V]{next: a.freeCount}
+		a.freeCount = i
+		a.freeLength++
+	}
+}
+
+func (a *chainedDict[K, V]) Iterator() seq.Iterator[Entry[K, V]] {
+	return &chainedIterator[K, V]{-1, a}
+}
+
+type chainedIterator[K comparable, V any] struct {
+	index  int
+	source *chainedDict[K, V]
+}
+
+func (a *chainedIterator[K, V]) Next() option.Option[Entry[K, V]] {
+	for a.index < len(a.source.entries)-1 {
+		a.index++
+		var item = a.source.entries[a.index]
+		if item.alive {
+			return option.Some(Entry[K, V]{item.key, item.value})
+		}
+	}
+	return option.None[Entry[K, V]]()
+}
+
+func (a *chainedDict[K, V]) grow(minCapacity int) {
+	if minCapacity/len(a.buckets) > 1 {
+		var newBuckets = make([]int, len(a.buckets)*2)
+		for i := 0; i < len(newBuckets); i++ {
+			newBuckets[i] = -1
+		}
+		for i, v := range a.entries {
+			if v.alive {
+				var bucket = v.hash % uint64(len(newBuckets))
+				a.entries[i].next = newBuckets[bucket]
+				newBuckets[bucket] = i
+			}
+		}
+		a.buckets = newBuckets
+	}
+	if minCapacity > len(a.entries) {
+		var newEntries = make([]chainedEntry[K, V], len(a.entries)+len(a.entries)>>1)
+		copy(newEntries, a.entries)
+		a.entries = newEntries
+	}
+}
+
+func benchmarkKeys() []int {
+	var keys = make([]int, benchmarkLength)
+	for i := range keys {
+		keys[i] = i * 7919
+	}
+	return keys
+}
+
+func filledDict(keys []int) *Dict[int, int] {
+	var d = Make[int, int](0)
+	for _, k := range keys {
+		d.Add(k, k)
+	}
+	return d
+}
+
+func filledChained(keys []int) *chainedDict[int, int] {
+	var d = makeChained[int, int](DefaultHasher[int]())
+	for _, k := range keys {
+		d.Add(k, k)
+	}
+	return d
+}
+
+func filledMap(keys []int) map[int]int {
+	var d = map[int]int{}
+	for _, k := range keys {
+		d[k] = k
+	}
+	return d
+}
+
+func BenchmarkInsert(b *testing.B) {
+	var keys = benchmarkKeys()
+	b.Run("Dict", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			filledDict(keys)
+		}
+	})
+	b.Run("Chained", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			filledChained(keys)
+		}
+	})
+	b.Run("Map", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			filledMap(keys)
+		}
+	})
+}
+
+func BenchmarkLookupHit(b *testing.B) {
+	var keys = benchmarkKeys()
+	b.Run("Dict", func(b *testing.B) {
+		var d = filledDict(keys)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			d.At(keys[i&(benchmarkLength-1)])
+		}
+	})
+	b.Run("Chained", func(b *testing.B) {
+		var d = filledChained(keys)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			d.At(keys[i&(benchmarkLength-1)])
+		}
+	})
+	b.Run("Map", func(b *testing.B) {
+		var d = filledMap(keys)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = d[keys[i&(benchmarkLength-1)]]
+		}
+	})
+}
+
+func BenchmarkLookupMiss(b *testing.B) {
+	var keys = benchmarkKeys()
+	b.Run("Dict", func(b *testing.B) {
+		var d = filledDict(keys)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			d.At(keys[i&(benchmarkLength-1)] + 1)
+		}
+	})
+	b.Run("Chained", func(b *testing.B) {
+		var d = filledChained(keys)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			d.At(keys[i&(benchmarkLength-1)] + 1)
+		}
+	})
+	b.Run("Map", func(b *testing.B) {
+		var d = filledMap(keys)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = d[keys[i&(benchmarkLength-1)]+1]
+		}
+	})
+}
+
+func BenchmarkDelete(b *testing.B) {
+	var keys = benchmarkKeys()
+	b.Run("Dict", func(b *testing.B) {
+		var d = filledDict(keys)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			var k = keys[i&(benchmarkLength-1)]
+			d.Remove(k)
+			d.Add(k, k)
+		}
+	})
+	b.Run("Chained", func(b *testing.B) {
+		var d = filledChained(keys)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			var k = keys[i&(benchmarkLength-1)]
+			d.Remove(k)
+			d.Add(k, k)
+		}
+	})
+	b.Run("Map", func(b *testing.B) {
+		var d = filledMap(keys)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			var k = keys[i&(benchmarkLength-1)]
+			delete(d, k)
+			d[k] = k
+		}
+	})
+}
+
+func BenchmarkIterate(b *testing.B) {
+	var keys = benchmarkKeys()
+	b.Run("Dict", func(b *testing.B) {
+		var d = filledDict(keys)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			var sum = 0
+			var iter = d.Iterator()
+			for {
+				if v, ok := iter.Next().Val(); ok {
+					sum += v.Value
+				} else {
+					break
+				}
+			}
+		}
+	})
+	b.Run("Chained", func(b *testing.B) {
+		var d = filledChained(keys)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			var sum = 0
+			var iter = d.Iterator()
+			for {
+				if v, ok := iter.Next().Val(); ok {
+					sum += v.Value
+				} else {
+					break
+				}
+			}
+		}
+	})
+	b.Run("Map", func(b *testing.B) {
+		var d = filledMap(keys)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			var sum = 0
+			for _, v := range d {
+				sum += v
+			}
+		}
+	})
+}
@@ -0,0 +1,48 @@
+package dict
+
+import "github.com/kulics/gollection/seq"
+
+// Remove the entries that satisfy predicate in one pass, return the number of removed entries.
+func (a *Dict[K, V]) RemoveIf(predicate func(K, V) bool) int {
+	var removed = 0
+	for i := 0; i < len(a.entries); i++ {
+		if a.full(i) && predicate(a.entries[i].key, a.entries[i].value) {
+			a.erase(i)
+			removed++
+		}
+	}
+	return removed
+}
+
+// Replace the value of each entry with the result of transform, return the number of replaced entries.
+func (a *Dict[K, V]) ReplaceAll(transform func(K, V) V) int {
+	for i := 0; i < len(a.entries); i++ {
+		if a.full(i) {
+			a.entries[i].value = transform(a.entries[i].key, a.entries[i].value)
+		}
+	}
+	return a.length
+}
+
+// Remove the entries of the keys, return the number of removed entries.
+func (a *Dict[K, V]) RemoveAll(keys seq.Collection[K]) int {
+	var removed = 0
+	seq.ForEach[K](func(k K) {
+		if a.Remove(k).IsSome() {
+			removed++
+		}
+	}, keys)
+	return removed
+}
+
+// Remove the entries whose keys are not included in keys, return the number of removed entries.
+func (a *Dict[K, V]) RetainAll(keys seq.Collection[K]) int {
+	// The keys are compared by the hasher and equality of this dict.
+	var retained = MakeWithEqualer[K, struct{}](a.hash, a.equal, keys.Count())
+	seq.ForEach[K](func(k K) {
+		retained.Add(k, struct{}{})
+	}, keys)
+	return a.RemoveIf(func(k K, v V) bool {
+		return !retained.Contains(k)
+	})
+}
@@ -1,52 +1,37 @@
 package dict
 
 import (
-	"hash/maphash"
-	"unsafe"
+	"encoding/binary"
+	"math/bits"
 
+	"github.com/kulics/gollection/hash"
 	"github.com/kulics/gollection/option"
 	"github.com/kulics/gollection/ref"
 	"github.com/kulics/gollection/seq"
 )
 
-const defaultElementsLength = 10
+// The slots are split into groups, the control bytes of a group are matched together as an uint64.
+const groupSize = 8
 
-func arrayGrow(length int) int {
-	var newLength = length + (length >> 1)
-	if newLength < defaultElementsLength {
-		newLength = defaultElementsLength
-	}
-	return newLength
-}
-
-func defaultHashCode[K comparable]() func(k K) uint64 {
-	var h maphash.Hash
-	var seed = h.Seed()
-	var k K
-	switch ((any)(k)).(type) {
-	case string:
-		return func(key K) uint64 {
-			var strKey = *(*string)(unsafe.Pointer(&key))
-			h.SetSeed(seed)
-			h.WriteString(strKey)
-			return h.Sum64()
-		}
-	default:
-		return func(key K) uint64 {
-			var strKey = *(*string)(unsafe.Pointer(&struct {
-				data unsafe.Pointer
-				len  int
-			}{unsafe.Pointer(&k), int(unsafe.Sizeof(k))}))
-			h.SetSeed(seed)
-			h.WriteString(strKey)
-			return h.Sum64()
-		}
-	}
+// The control byte of a slot is empty, deleted, or the low 7 bits of the hash when the slot is full.
+const (
+	controlEmpty   uint8 = 0x80
+	controlDeleted uint8 = 0xfe
+)
+
+const (
+	lsbs = 0x0101010101010101
+	msbs = 0x8080808080808080
+)
+
+// Return the hasher used by Make, it is safe for concurrent use.
+func DefaultHasher[K comparable]() func(K) uint64 {
+	return hash.Default[K]().Hash
 }
 
 func Of[K comparable, V any](elements ...Entry[K, V]) *Dict[K, V] {
 	var length = len(elements)
-	var dict = MakeWithHasher[K, V](defaultHashCode[K](), length)
+	var dict = MakeWithHasher[K, V](DefaultHasher[K](), length)
 	for _, v := range elements {
 		dict.Add(v.Key, v.Value)
 	}
@@ -54,65 +39,64 @@ func Of[K comparable, V any](elements ...Entry[K, V]) *Dict[K, V] {
 }
 
 func Make[K comparable, V any](capacity int) *Dict[K, V] {
-	return MakeWithHasher[K, V](defaultHashCode[K](), capacity)
+	return MakeWithHasher[K, V](DefaultHasher[K](), capacity)
 }
 
 func MakeWithHasher[K comparable, V any](hasher func(K) uint64, capacity int) *Dict[K, V] {
-	var length = capacity
-	var buckets = make([]int, bucketsLengthFor(length))
-	for i := 0; i < len(buckets); i++ {
-		buckets[i] = -1
-	}
-	if length < defaultElementsLength {
-		length = defaultElementsLength
-	}
-	return &Dict[K, V]{
-		buckets:    buckets,
-		entries:    make([]entry[K, V], length),
-		hash:       hasher,
-		loadFactor: 1,
-		seed:       maphash.MakeSeed(),
+	return MakeWithEqualer[K, V](hasher, nil, capacity)
+}
+
+// Constructing a Dict with custom hashing and equality of keys, keys that are equal must have the same hash.
+// The == operator is used when equal is nil.
+func MakeWithEqualer[K comparable, V any](hasher func(K) uint64, equal func(K, K) bool, capacity int) *Dict[K, V] {
+	var dict = &Dict[K, V]{
+		hash:  hasher,
+		equal: equal,
 	}
+	dict.resize(slotsLengthFor(capacity))
+	return dict
 }
 
 func From[K comparable, V any](collection seq.Collection[Entry[K, V]]) *Dict[K, V] {
 	var length = collection.Count()
-	var dict = MakeWithHasher[K, V](defaultHashCode[K](), length)
+	var dict = MakeWithHasher[K, V](DefaultHasher[K](), length)
 	seq.ForEach[Entry[K, V]](func(t Entry[K, V]) {
 		dict.Add(t.Key, t.Value)
 	}, collection)
 	return dict
 }
 
-func bucketsLengthFor(length int) int {
-	var bucketsLength = 16
-	for bucketsLength < length {
-		bucketsLength = bucketsLength * 2
+// Return the number of slots that can hold length entries under the max load factor of 7/8.
+func slotsLengthFor(length int) int {
+	var slotsLength = groupSize
+	for slotsLength/8*7 < length {
+		slotsLength = slotsLength * 2
 	}
-	return bucketsLength
+	return slotsLength
 }
 
+// Dict is a hash table using open addressing, the slots are probed group by group,
+// and the control bytes of a group are matched at once to skip the slots with other hashes.
+// The iterators panic with seq.ConcurrentModification when entries are added or removed during iteration,
+// except by the Remove of the iterator itself.
 type Dict[K comparable, V any] struct {
-	buckets     []int
-	entries     []entry[K, V]
-	appendCount int
-	freeCount   int
-	freeLength  int
-	hash        func(K) uint64
-	loadFactor  float64
-	seed        maphash.Seed
+	controls   []uint8
+	entries    []entry[K, V]
+	length     int
+	growthLeft int
+	modCount   int
+	hash       func(K) uint64
+	equal      func(K, K) bool
 }
 
 type entry[K any, V any] struct {
 	hash  uint64
 	key   K
 	value V
-	next  int
-	alive bool
 }
 
 func (a *Dict[K, V]) Count() int {
-	return a.appendCount - a.freeLength
+	return a.length
 }
 
 func (a *Dict[K, V]) Contains(key K) bool {
@@ -121,167 +105,315 @@ func (a *Dict[K, V]) Contains(key K) bool {
 
 func (a *Dict[K, V]) At(key K) ref.Ref[V] {
 	var hash = a.hash(key)
-	var index = a.index(hash)
-	for i := a.buckets[index]; i >= 0; i = a.entries[i].next {
-		var item = a.entries[i]
-		if item.hash == hash && item.key == key {
-			return ref.Of(&a.entries[i].value)
-		}
+	if i := a.find(hash, key); i >= 0 {
+		return ref.Of(&a.entries[i].value)
 	}
 	return ref.Of[V](nil)
 }
 
 func (a *Dict[K, V]) Add(key K, value V) option.Option[V] {
 	var hash = a.hash(key)
-	var index = a.index(hash)
-	for i := a.buckets[index]; i >= 0; i = a.entries[i].next {
-		var item = a.entries[i]
-		if item.hash == hash && item.key == key {
-			var newItem = entry[K, V]{
-				hash:  item.hash,
-				key:   item.key,
-				value: value,
-				next:  item.next,
-				alive: item.alive,
-			}
-			a.entries[i] = newItem
-			return option.Some(item.value)
-		}
+	if i := a.find(hash, key); i >= 0 {
+		var old = a.entries[i].value
+		a.entries[i].value = value
+		return option.Some(old)
 	}
-	var bucket int
-	if a.freeLength > 0 {
-		bucket = a.freeCount
-		a.freeCount = a.entries[a.freeCount].next
-		a.freeLength--
-	} else {
-		if a.grow(a.Count() + 1) {
-			index = a.index(hash)
+	a.insert(hash, key, value)
+	return option.None[V]()
+}
+
+func (a *Dict[K, V]) Remove(key K) option.Option[V] {
+	var hash = a.hash(key)
+	if i := a.find(hash, key); i >= 0 {
+		return option.Some(a.erase(i))
+	}
+	return option.None[V]()
+}
+
+// Return the value of the key, or add the value when the key is not included.
+func (a *Dict[K, V]) GetOrAdd(key K, value V) ref.Ref[V] {
+	var hash = a.hash(key)
+	if i := a.find(hash, key); i >= 0 {
+		return ref.Of(&a.entries[i].value)
+	}
+	return ref.Of(&a.entries[a.insert(hash, key, value)].value)
+}
+
+// Return the value of the key, or add the value created by supplier when the key is not included.
+// The supplier is only called when the key is not included.
+func (a *Dict[K, V]) GetOrAddWith(key K, supplier func() V) ref.Ref[V] {
+	var hash = a.hash(key)
+	if i := a.find(hash, key); i >= 0 {
+		return ref.Of(&a.entries[i].value)
+	}
+	return ref.Of(&a.entries[a.insert(hash, key, supplier())].value)
+}
+
+// Compute the new value of the key from the old value, the old value is None when the key is not included.
+// The key is added or updated when the new value is Some, and removed when the new value is None.
+// Return the new value.
+func (a *Dict[K, V]) Compute(key K, remapping func(old option.Option[V]) option.Option[V]) option.Option[V] {
+	var hash = a.hash(key)
+	var i = a.find(hash, key)
+	var old = option.None[V]()
+	if i >= 0 {
+		old = option.Some(a.entries[i].value)
+	}
+	var result = remapping(old)
+	if v, ok := result.Val(); ok {
+		if i >= 0 {
+			a.entries[i].value = v
+		} else {
+			a.insert(hash, key, v)
 		}
-		bucket = a.appendCount
-		a.appendCount++
+	} else if i >= 0 {
+		a.erase(i)
 	}
-	var newItem = entry[K, V]{
-		hash:  hash,
-		key:   key,
-		value: value,
-		next:  a.buckets[index],
-		alive: true,
+	return result
+}
+
+// Add the value when the key is not included, otherwise combine the old value with the value.
+// Return the new value.
+func (a *Dict[K, V]) Merge(key K, value V, combine func(old V, value V) V) V {
+	var hash = a.hash(key)
+	if i := a.find(hash, key); i >= 0 {
+		var result = combine(a.entries[i].value, value)
+		a.entries[i].value = result
+		return result
 	}
-	a.entries[bucket] = newItem
-	a.buckets[index] = bucket
-	return option.None[V]()
+	a.insert(hash, key, value)
+	return value
 }
 
-func (a *Dict[K, V]) Remove(key K) option.Option[V] {
+// Replace the value of the key with the result of updater.
+// Returns false if the key is not included.
+func (a *Dict[K, V]) Update(key K, updater func(V) V) bool {
 	var hash = a.hash(key)
-	var index = a.index(hash)
-	var last = -1
-	for i := a.buckets[index]; i >= 0; i = a.entries[i].next {
-		var item = a.entries[i]
-		if item.hash == hash && item.key == key {
-			if last < 0 {
-				a.buckets[index] = a.entries[i].next
-			} else {
-				var item = a.entries[last]
-				item.next = a.entries[i].next
-				a.entries[last] = item
-			}
-			var nilK K
-			var nilV V
-			var empty = entry[K, V]{
-				next:  a.freeCount,
-				key:   nilK,
-				value: nilV,
+	if i := a.find(hash, key); i >= 0 {
+		a.entries[i].value = updater(a.entries[i].value)
+		return true
+	}
+	return false
+}
+
+// Return the index of the entry of the key, or -1 when the key is not included.
+func (a *Dict[K, V]) find(hash uint64, key K) int {
+	var control = uint8(hash & 0x7f)
+	var groupMask = len(a.controls)/groupSize - 1
+	var group = int(hash>>7) & groupMask
+	for step := 1; ; step++ {
+		var controls = a.group(group)
+		for match := matchByte(controls, control); match != 0; match &= match - 1 {
+			var i = group*groupSize + bits.TrailingZeros64(match)/8
+			if item := &a.entries[i]; item.hash == hash && a.equals(item.key, key) {
+				return i
 			}
-			a.entries[i] = empty
-			a.freeCount = i
-			a.freeCount++
-			return option.Some(item.value)
 		}
+		// The probing stops at a group with an empty slot, the key would have been added there.
+		if matchEmpty(controls) != 0 {
+			return -1
+		}
+		group = (group + step) & groupMask
 	}
-	return option.None[V]()
+}
+
+// Return the index of the first empty or deleted slot in the probing of hash.
+func (a *Dict[K, V]) findSlot(hash uint64) int {
+	var groupMask = len(a.controls)/groupSize - 1
+	var group = int(hash>>7) & groupMask
+	for step := 1; ; step++ {
+		if match := matchEmptyOrDeleted(a.group(group)); match != 0 {
+			return group*groupSize + bits.TrailingZeros64(match)/8
+		}
+		group = (group + step) & groupMask
+	}
+}
+
+// Return an empty dict with capacity, which has the same hashing and equality of keys as dict.
+func (a *Dict[K, V]) CloneEmpty(capacity int) *Dict[K, V] {
+	return MakeWithEqualer[K, V](a.hash, a.equal, capacity)
+}
+
+// Returns true if the keys are equal by the equality of dict.
+func (a *Dict[K, V]) KeyEquals(l K, r K) bool {
+	return a.equals(l, r)
+}
+
+func (a *Dict[K, V]) equals(l K, r K) bool {
+	if a.equal == nil {
+		return l == r
+	}
+	return a.equal(l, r)
+}
+
+// Add a new entry of the key that is not included, return the index of the entry.
+func (a *Dict[K, V]) insert(hash uint64, key K, value V) int {
+	var i = a.findSlot(hash)
+	if a.controls[i] == controlEmpty && a.growthLeft == 0 {
+		a.rehash(a.length + 1)
+		i = a.findSlot(hash)
+	}
+	if a.controls[i] == controlEmpty {
+		a.growthLeft--
+	}
+	a.controls[i] = uint8(hash & 0x7f)
+	a.entries[i] = entry[K, V]{hash, key, value}
+	a.length++
+	a.modCount++
+	return i
+}
+
+// Remove the entry at i, return the removed value.
+func (a *Dict[K, V]) erase(i int) V {
+	var value = a.entries[i].value
+	a.entries[i] = entry[K, V]{}
+	a.length--
+	a.modCount++
+	// The group with an empty slot has never been full, so no probing has passed it,
+	// and the slot can be empty again, otherwise a tombstone is left to keep the probing going on.
+	if matchEmpty(a.group(i/groupSize)) != 0 {
+		a.controls[i] = controlEmpty
+		a.growthLeft++
+	} else {
+		a.controls[i] = controlDeleted
+	}
+	return value
 }
 
 func (a *Dict[K, V]) Clear() {
-	for i := 0; i < len(a.buckets); i++ {
-		a.buckets[i] = -1
+	for i := 0; i < len(a.controls); i++ {
+		a.controls[i] = controlEmpty
 	}
 	for i := 0; i < len(a.entries); i++ {
 		a.entries[i] = entry[K, V]{}
 	}
+	a.length = 0
+	a.growthLeft = len(a.controls) / 8 * 7
+	a.modCount++
 }
 
+// Return the Iterator of dict, it implements seq.MutableIterator.
 func (a *Dict[K, V]) Iterator() seq.Iterator[Entry[K, V]] {
-	return &hashDictIterator[K, V]{-1, a}
+	return &hashDictIterator[K, V]{a.slotIterator()}
 }
 
 func (a *Dict[K, V]) Clone() *Dict[K, V] {
-	var buckets = make([]int, len(a.buckets))
-	copy(buckets, a.buckets)
+	var controls = make([]uint8, len(a.controls))
+	copy(controls, a.controls)
 	var entries = make([]entry[K, V], len(a.entries))
 	copy(entries, a.entries)
 	return &Dict[K, V]{
-		buckets:     buckets,
-		entries:     entries,
-		appendCount: a.appendCount,
-		freeCount:   a.freeCount,
-		freeLength:  a.freeLength,
-		hash:        a.hash,
-		loadFactor:  a.loadFactor,
+		controls:   controls,
+		entries:    entries,
+		length:     a.length,
+		growthLeft: a.growthLeft,
+		hash:       a.hash,
+		equal:      a.equal,
 	}
 }
 
-func (a *Dict[K, V]) grow(minCapacity int) bool {
-	var entriesLength = len(a.entries)
-	var bucketsLength = len(a.buckets)
-	var isRehash = false
-	if float64(minCapacity/bucketsLength) > a.loadFactor {
-		var newBucketsLength = bucketsLength * 2
-		var newBuckets = make([]int, newBucketsLength)
-		for i := 0; i < len(newBuckets); i++ {
-			newBuckets[i] = -1
-		}
-		for i, v := range a.entries {
-			if v.alive {
-				var bucket = int(v.hash % uint64(newBucketsLength))
-				v.next = newBuckets[bucket]
-				a.entries[i] = v
-				newBuckets[bucket] = i
-			}
-		}
-		a.buckets = newBuckets
-		isRehash = true
+// Move the entries to new slots that can hold minCapacity entries, which also drops the tombstones.
+func (a *Dict[K, V]) rehash(minCapacity int) {
+	var slotsLength = len(a.controls)
+	if minCapacity > slotsLength/16*7 {
+		slotsLength = slotsLengthFor(minCapacity * 2)
 	}
-	if minCapacity > entriesLength {
-		var newLength = entriesLength + (entriesLength >> 1)
-		if newLength < minCapacity {
-			newLength = minCapacity
+	var controls, entries = a.controls, a.entries
+	a.resize(slotsLength)
+	for i, v := range controls {
+		if v&controlEmpty == 0 {
+			var item = entries[i]
+			var j = a.findSlot(item.hash)
+			a.controls[j] = v
+			a.entries[j] = item
 		}
-		var newEntries = make([]entry[K, V], newLength)
-		copy(newEntries, a.entries)
-		a.entries = newEntries
 	}
-	return isRehash
 }
 
-func (a *Dict[K, V]) index(hash uint64) int {
-	return int(hash % uint64(len(a.buckets)))
+func (a *Dict[K, V]) resize(slotsLength int) {
+	a.controls = make([]uint8, slotsLength)
+	for i := 0; i < slotsLength; i++ {
+		a.controls[i] = controlEmpty
+	}
+	a.entries = make([]entry[K, V], slotsLength)
+	a.growthLeft = slotsLength/8*7 - a.length
+}
+
+func (a *Dict[K, V]) group(index int) uint64 {
+	return binary.LittleEndian.Uint64(a.controls[index*groupSize:])
+}
+
+// Returns true if the slot at i holds an entry.
+func (a *Dict[K, V]) full(i int) bool {
+	return a.controls[i]&controlEmpty == 0
+}
+
+// Return the bytes of controls equal to control as the high bits of a mask,
+// there may be false positives next to the true ones, which are filtered by comparing the keys.
+func matchByte(controls uint64, control uint8) uint64 {
+	var x = controls ^ (lsbs * uint64(control))
+	return (x - lsbs) &^ x & msbs
+}
+
+func matchEmpty(controls uint64) uint64 {
+	return controls &^ (controls << 6) & msbs
+}
+
+func matchEmptyOrDeleted(controls uint64) uint64 {
+	return controls & msbs
 }
 
 type hashDictIterator[K comparable, V any] struct {
-	index  int
-	source *Dict[K, V]
+	slotIterator[K, V]
 }
 
 func (a *hashDictIterator[K, V]) Next() option.Option[Entry[K, V]] {
+	if a.advance() {
+		var item = a.source.entries[a.index]
+		return option.Some(Entry[K, V]{item.key, item.value})
+	}
+	return option.None[Entry[K, V]]()
+}
+
+func (a *Dict[K, V]) slotIterator() slotIterator[K, V] {
+	return slotIterator[K, V]{index: -1, source: a, modCount: a.modCount}
+}
+
+// slotIterator walks the full slots of dict, it is shared by the iterators of entries, keys and values.
+type slotIterator[K comparable, V any] struct {
+	index     int
+	source    *Dict[K, V]
+	modCount  int
+	removable bool
+}
+
+// Move to the next full slot, returns false if there is no more slot.
+func (a *slotIterator[K, V]) advance() bool {
+	if a.modCount != a.source.modCount {
+		panic(seq.ConcurrentModification)
+	}
 	for a.index < len(a.source.entries)-1 {
 		a.index++
-		var item = a.source.entries[a.index]
-		if item.alive {
-			return option.Some(Entry[K, V]{item.key, item.value})
+		if a.source.full(a.index) {
+			a.removable = true
+			return true
 		}
 	}
-	return option.None[Entry[K, V]]()
+	a.removable = false
+	return false
+}
+
+func (a *slotIterator[K, V]) Remove() {
+	if !a.removable {
+		panic(seq.IllegalState)
+	}
+	if a.modCount != a.source.modCount {
+		panic(seq.ConcurrentModification)
+	}
+	// The other entries stay in their slots, so the iteration can go on.
+	a.source.erase(a.index)
+	a.modCount = a.source.modCount
+	a.removable = false
 }
 
 func Collector[K comparable, V any]() seq.Collector[*Dict[K, V], Entry[K, V], *Dict[K, V]] {
@@ -2,7 +2,14 @@ package dict
 
 import (
 	"fmt"
+	"math/rand"
+	"strings"
 	"testing"
+
+	"github.com/kulics/gollection/hash"
+	"github.com/kulics/gollection/internal/panics"
+	"github.com/kulics/gollection/option"
+	"github.com/kulics/gollection/seq"
 )
 
 func TestHashDict(t *testing.T) {
@@ -48,4 +55,299 @@ func TestHashDict(t *testing.T) {
 	if v, ok := dict2.At(111).Val(); !ok || v != 2 {
 		t.Fatal("dict value not eq 2")
 	}
+	for i := 0; i < 100; i++ {
+		dict2.Add(i, i)
+	}
+	for i := 0; i < 100; i += 2 {
+		if dict2.Remove(i).OrPanic() != i {
+			t.Fatal("dict removed value error")
+		}
+	}
+	if dict2.Count() != 51 {
+		t.Fatal("dict count not eq 51")
+	}
+	for i := 1; i < 100; i += 2 {
+		if dict2.At(i).Get() != i {
+			t.Fatal("dict value error after remove")
+		}
+	}
+	dict2.Clear()
+	if dict2.Count() != 0 {
+		t.Fatal("dict count not eq 0")
+	}
+	var dict3 = Make[int, int](0)
+	for i := 0; i < 100; i++ {
+		dict3.Add(i, i)
+	}
+	var iter = dict3.Iterator().(seq.MutableIterator[Entry[int, int]])
+	for {
+		if v, ok := iter.Next().Val(); ok {
+			if v.Key%3 != 0 {
+				iter.Remove()
+			}
+		} else {
+			break
+		}
+	}
+	if dict3.Count() != 34 || !dict3.Contains(99) || dict3.Contains(98) {
+		t.Fatal("iterator remove error")
+	}
+	var keys = dict3.KeySet().Iterator().(seq.MutableIterator[int])
+	keys.Next()
+	keys.Remove()
+	if panics.Recover(keys.Remove) != seq.IllegalState || dict3.Count() != 33 {
+		t.Fatal("keys iterator remove error")
+	}
+	var values = dict3.ValueCollection().Iterator()
+	dict3.ReplaceAll(func(k int, v int) int { return v + 1 })
+	values.Next()
+	dict3.Add(1000, 1000)
+	if panics.Recover(func() { values.Next() }) != seq.ConcurrentModification {
+		t.Fatal("fail fast error")
+	}
+}
+
+func TestHashDictProbing(t *testing.T) {
+	var random = rand.New(rand.NewSource(1))
+	// The weak hasher puts many keys in the same groups to exercise the probing and tombstones.
+	var dict = MakeWithHasher[int, int](func(k int) uint64 { return uint64(k % 64) }, 0)
+	var expected = map[int]int{}
+	for i := 0; i < 20000; i++ {
+		var key = random.Intn(500)
+		switch random.Intn(3) {
+		case 0, 1:
+			if _, ok := expected[key]; dict.Add(key, i).IsSome() != ok || ok && dict.At(key).Get() != i {
+				t.Fatal("dict add error")
+			}
+			expected[key] = i
+		default:
+			var old, ok = expected[key]
+			if v, removed := dict.Remove(key).Val(); removed != ok || ok && v != old {
+				t.Fatal("dict remove error")
+			}
+			delete(expected, key)
+		}
+		if dict.Count() != len(expected) {
+			t.Fatal("dict count error")
+		}
+	}
+	for k, v := range expected {
+		if dict.At(k).Get() != v {
+			t.Fatal("dict value error")
+		}
+	}
+	var count = 0
+	seq.ForEach[Entry[int, int]](func(e Entry[int, int]) {
+		if expected[e.Key] != e.Value {
+			t.Fatal("dict iterator error")
+		}
+		count++
+	}, dict)
+	if count != len(expected) {
+		t.Fatal("dict iterator count error")
+	}
+	var used = 0
+	for i := range dict.controls {
+		if dict.controls[i] != controlEmpty {
+			used++
+		}
+	}
+	if used+dict.growthLeft != len(dict.controls)/8*7 {
+		t.Fatal("dict growth left error")
+	}
+}
+
+func TestHashDictBulk(t *testing.T) {
+	var dict = Make[int, int](0)
+	for i := 0; i < 100; i++ {
+		dict.Add(i, i)
+	}
+	if dict.RemoveIf(func(k int, v int) bool { return k%2 == 0 }) != 50 || dict.Count() != 50 || dict.Contains(2) {
+		t.Fatal("remove if error")
+	}
+	if dict.ReplaceAll(func(k int, v int) int { return v * 2 }) != 50 || dict.At(3).Get() != 6 {
+		t.Fatal("replace all error")
+	}
+	if dict.RemoveAll(seq.Slice[int]{1, 2, 3}) != 2 || dict.Count() != 48 {
+		t.Fatal("remove all error")
+	}
+	if dict.RetainAll(seq.Slice[int]{5, 7, 8}) != 46 || dict.Count() != 2 || dict.At(7).Get() != 14 {
+		t.Fatal("retain all error")
+	}
+}
+
+type point struct {
+	x    int
+	name string
+}
+
+func TestHashDictEqualer(t *testing.T) {
+	var points = Make[point, int](0)
+	points.Add(point{1, strings.Repeat("a", 2)}, 1)
+	if !points.Contains(point{1, "aa"}) || points.Contains(point{1, "a"}) {
+		t.Fatal("default hasher error")
+	}
+	var insensitive = MakeWithEqualer[string, int](
+		hash.By(strings.ToLower, hash.String[string]()).Hash,
+		hash.EqualBy(strings.ToLower).Equal,
+		0,
+	)
+	insensitive.Add("Go", 1)
+	if insensitive.Add("gO", 2).OrPanic() != 1 || insensitive.Count() != 1 || insensitive.At("GO").Get() != 2 {
+		t.Fatal("equaler error")
+	}
+	var cloned = insensitive.Clone()
+	if cloned.Remove("go").IsNone() || cloned.Count() != 0 || insensitive.Count() != 1 {
+		t.Fatal("equaler clone error")
+	}
+	if empty := insensitive.CloneEmpty(0); empty.Count() != 0 || !empty.KeyEquals("Go", "GO") {
+		t.Fatal("equaler clone empty error")
+	}
+	var linked = MakeLinkedWithEqualer[string, int](
+		hash.By(strings.ToLower, hash.String[string]()).Hash,
+		hash.EqualBy(strings.ToLower).Equal,
+		0,
+	)
+	linked.Add("Go", 1)
+	if linked.Add("GO", 2).IsNone() || linked.Clone().Add("gO", 3).IsNone() || linked.Count() != 1 {
+		t.Fatal("linked equaler error")
+	}
+}
+
+func TestHashDictEntry(t *testing.T) {
+	var dict = Of[string, int]()
+	if dict.GetOrAdd("a", 1).Get() != 1 || dict.GetOrAdd("a", 2).Get() != 1 {
+		t.Fatal("dict get or add error")
+	}
+	*dict.GetOrAdd("a", 0).Ptr += 1
+	if dict.At("a").Get() != 2 {
+		t.Fatal("dict get or add ref error")
+	}
+	var called = false
+	dict.GetOrAddWith("a", func() int {
+		called = true
+		return 0
+	})
+	if called || dict.GetOrAddWith("b", func() int { return 5 }).Get() != 5 {
+		t.Fatal("dict get or add with error")
+	}
+	var increment = func(old option.Option[int]) option.Option[int] {
+		return option.Some(old.Or(0) + 1)
+	}
+	if dict.Compute("a", increment).OrPanic() != 3 || dict.Compute("c", increment).OrPanic() != 1 {
+		t.Fatal("dict compute error")
+	}
+	dict.Compute("c", func(old option.Option[int]) option.Option[int] {
+		return option.None[int]()
+	})
+	if dict.Contains("c") || dict.Count() != 2 {
+		t.Fatal("dict compute remove error")
+	}
+	var add = func(a, b int) int {
+		return a + b
+	}
+	if dict.Merge("a", 10, add) != 13 || dict.Merge("d", 10, add) != 10 {
+		t.Fatal("dict merge error")
+	}
+	if !dict.Update("d", func(v int) int { return v * 2 }) || dict.At("d").Get() != 20 {
+		t.Fatal("dict update error")
+	}
+	if dict.Update("e", func(v int) int { return v }) || dict.Contains("e") {
+		t.Fatal("dict update absent error")
+	}
+}
+
+func TestHashDictView(t *testing.T) {
+	var dict = FromKeys[string, int](seq.Slice[string]{"a", "bb", "ccc"}, func(k string) int {
+		return len(k)
+	})
+	var keys = dict.KeySet()
+	var values = dict.ValueCollection()
+	if keys.Count() != 3 || values.Count() != 3 {
+		t.Fatal("view count not eq 3")
+	}
+	if !keys.Contains("bb") || keys.Contains("d") {
+		t.Fatal("keys view contains error")
+	}
+	if seq.Sum[int](values) != 6 {
+		t.Fatal("values view sum error")
+	}
+	dict.Add("dddd", 4)
+	dict.Remove("a")
+	if keys.Count() != 3 || !keys.Contains("dddd") || keys.Contains("a") {
+		t.Fatal("keys view not reflect dict")
+	}
+	if seq.Sum[int](values) != 9 {
+		t.Fatal("values view not reflect dict")
+	}
+	if ToKeySlice(dict).Count() != 3 || seq.Sum[int](ToValueSlice(dict)) != 9 {
+		t.Fatal("to slice error")
+	}
+}
+
+func TestLinkedDict(t *testing.T) {
+	var keysOf = func(it seq.Sequence[Entry[string, int]]) string {
+		return seq.Fold("", func(r string, e Entry[string, int]) string {
+			return r + e.Key
+		}, it)
+	}
+	var dict = OfLinked(Entry[string, int]{"c", 3}, Entry[string, int]{"a", 1}, Entry[string, int]{"b", 2})
+	if dict.Count() != 3 || keysOf(dict) != "cab" {
+		t.Fatal("linked dict insertion order error")
+	}
+	dict.Remove("a")
+	dict.Add("a", 4)
+	dict.Add("c", 5)
+	if keysOf(dict) != "cba" || dict.At("c").Get() != 5 {
+		t.Fatal("linked dict order after remove error")
+	}
+	if keysOf(dict.Reversed()) != "abc" {
+		t.Fatal("linked dict reversed error")
+	}
+	if !dict.MoveToFront("a") || !dict.MoveToBack("c") || dict.MoveToBack("d") {
+		t.Fatal("linked dict move error")
+	}
+	if keysOf(dict) != "abc" {
+		t.Fatal("linked dict move order error")
+	}
+	if dict.FirstEntry().OrPanic().Key != "a" || dict.LastEntry().OrPanic().Key != "c" {
+		t.Fatal("linked dict first or last error")
+	}
+	if dict.RemoveFirst().OrPanic().Value != 4 || dict.Contains("a") || dict.Count() != 2 {
+		t.Fatal("linked dict remove first error")
+	}
+	var clone = dict.Clone()
+	clone.Clear()
+	if clone.Count() != 0 || keysOf(dict) != "bc" {
+		t.Fatal("linked dict clone error")
+	}
+	var access = MakeLinkedAccessOrdered[string, int](0)
+	access.Add("a", 1)
+	access.Add("b", 2)
+	access.Add("c", 3)
+	access.At("a")
+	access.Add("b", 4)
+	if keysOf(access) != "cab" {
+		t.Fatal("linked dict access order error")
+	}
+	if access.RemoveLast().OrPanic().Key != "b" || access.RemoveFirst().OrPanic().Key != "c" {
+		t.Fatal("linked dict remove error")
+	}
+	var linked = OfLinked(Entry[int, int]{1, 1}, Entry[int, int]{2, 2}, Entry[int, int]{3, 3})
+	var linkedIter = linked.Iterator().(seq.MutableIterator[Entry[int, int]])
+	linkedIter.Next()
+	linkedIter.Remove()
+	if linked.Count() != 2 || linked.Contains(1) || linked.FirstEntry().OrPanic().Key != 2 {
+		t.Fatal("linked iterator remove error")
+	}
+	linked.Remove(3)
+	if panics.Recover(func() { linkedIter.Next() }) != seq.ConcurrentModification {
+		t.Fatal("linked fail fast error")
+	}
+	var reversed = linked.Reversed().Iterator()
+	reversed.Next()
+	linked.MoveToFront(2)
+	if panics.Recover(func() { reversed.Next() }) != seq.ConcurrentModification {
+		t.Fatal("linked reversed fail fast error")
+	}
 }
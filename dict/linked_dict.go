@@ -0,0 +1,236 @@
+package dict
+
+import (
+	list "github.com/kulics/gollection/linkedlist"
+	"github.com/kulics/gollection/option"
+	"github.com/kulics/gollection/ref"
+	"github.com/kulics/gollection/seq"
+)
+
+// Constructing a LinkedDict in insertion order with variable-length parameters.
+func OfLinked[K comparable, V any](elements ...Entry[K, V]) *LinkedDict[K, V] {
+	var dict = MakeLinked[K, V](len(elements))
+	for _, v := range elements {
+		dict.Add(v.Key, v.Value)
+	}
+	return dict
+}
+
+// Constructing an empty LinkedDict in insertion order with capacity.
+func MakeLinked[K comparable, V any](capacity int) *LinkedDict[K, V] {
+	return MakeLinkedWithHasher[K, V](DefaultHasher[K](), capacity)
+}
+
+// Constructing an empty LinkedDict in insertion order with hasher and capacity.
+func MakeLinkedWithHasher[K comparable, V any](hasher func(K) uint64, capacity int) *LinkedDict[K, V] {
+	return MakeLinkedWithEqualer[K, V](hasher, nil, capacity)
+}
+
+// Constructing an empty LinkedDict in insertion order with custom hashing and equality of keys,
+// keys that are equal must have the same hash. The == operator is used when equal is nil.
+func MakeLinkedWithEqualer[K comparable, V any](hasher func(K) uint64, equal func(K, K) bool, capacity int) *LinkedDict[K, V] {
+	return &LinkedDict[K, V]{
+		dict: MakeWithEqualer[K, *list.LinkedListNode[Entry[K, V]]](hasher, equal, capacity),
+		list: list.Of[Entry[K, V]](),
+	}
+}
+
+// Constructing an empty LinkedDict in access order with capacity,
+// the entry is moved to the back when it is accessed by At or Add.
+func MakeLinkedAccessOrdered[K comparable, V any](capacity int) *LinkedDict[K, V] {
+	var dict = MakeLinked[K, V](capacity)
+	dict.accessOrder = true
+	return dict
+}
+
+// Constructing a LinkedDict in insertion order from other Collection.
+func FromLinked[K comparable, V any](collection seq.Collection[Entry[K, V]]) *LinkedDict[K, V] {
+	var dict = MakeLinked[K, V](collection.Count())
+	seq.ForEach[Entry[K, V]](func(t Entry[K, V]) {
+		dict.Add(t.Key, t.Value)
+	}, collection)
+	return dict
+}
+
+// LinkedDict is a Dict that keeps the entries in a linked list,
+// the order of iteration is the insertion order or the access order, and is not changed by removals.
+type LinkedDict[K comparable, V any] struct {
+	dict        *Dict[K, *list.LinkedListNode[Entry[K, V]]]
+	list        *list.List[Entry[K, V]]
+	accessOrder bool
+}
+
+// Return the number of entries of dict.
+func (a *LinkedDict[K, V]) Count() int {
+	return a.dict.Count()
+}
+
+// Returns true if the key is included in the dict, it is not regarded as an access.
+func (a *LinkedDict[K, V]) Contains(key K) bool {
+	return a.dict.Contains(key)
+}
+
+// Return the value of the key.
+// Return nil when the key is not included.
+func (a *LinkedDict[K, V]) At(key K) ref.Ref[V] {
+	if node, ok := a.dict.At(key).Val(); ok {
+		if a.accessOrder {
+			a.list.MoveToBack(node)
+		}
+		return ref.Of(&node.Value.Value)
+	}
+	return ref.Of[V](nil)
+}
+
+// Add the value of the key, return the old value when the key has been included.
+// The new key is added at the back, and the position of an included key is kept in insertion order.
+func (a *LinkedDict[K, V]) Add(key K, value V) option.Option[V] {
+	if node, ok := a.dict.At(key).Val(); ok {
+		var old = node.Value.Value
+		node.Value.Value = value
+		if a.accessOrder {
+			a.list.MoveToBack(node)
+		}
+		return option.Some(old)
+	}
+	a.list.AddLast(Entry[K, V]{key, value})
+	a.dict.Add(key, a.list.Back())
+	return option.None[V]()
+}
+
+// Remove the key, return the removed value when the key has been included.
+func (a *LinkedDict[K, V]) Remove(key K) option.Option[V] {
+	if node, ok := a.dict.Remove(key).Val(); ok {
+		return option.Some(a.list.Remove(node).Value)
+	}
+	return option.None[V]()
+}
+
+// Return the entry at the front.
+// Return None when the dict is empty.
+func (a *LinkedDict[K, V]) FirstEntry() option.Option[Entry[K, V]] {
+	if node := a.list.Front(); node != nil {
+		return option.Some(node.Value)
+	}
+	return option.None[Entry[K, V]]()
+}
+
+// Return the entry at the back.
+// Return None when the dict is empty.
+func (a *LinkedDict[K, V]) LastEntry() option.Option[Entry[K, V]] {
+	if node := a.list.Back(); node != nil {
+		return option.Some(node.Value)
+	}
+	return option.None[Entry[K, V]]()
+}
+
+// Remove the entry at the front.
+// Return None when the dict is empty.
+func (a *LinkedDict[K, V]) RemoveFirst() option.Option[Entry[K, V]] {
+	if node := a.list.Front(); node != nil {
+		a.dict.Remove(node.Value.Key)
+		return option.Some(a.list.Remove(node))
+	}
+	return option.None[Entry[K, V]]()
+}
+
+// Remove the entry at the back.
+// Return None when the dict is empty.
+func (a *LinkedDict[K, V]) RemoveLast() option.Option[Entry[K, V]] {
+	if node := a.list.Back(); node != nil {
+		a.dict.Remove(node.Value.Key)
+		return option.Some(a.list.Remove(node))
+	}
+	return option.None[Entry[K, V]]()
+}
+
+// Move the entry of the key to the front.
+// Returns false if the key is not included.
+func (a *LinkedDict[K, V]) MoveToFront(key K) bool {
+	if node, ok := a.dict.At(key).Val(); ok {
+		a.list.MoveToFront(node)
+		return true
+	}
+	return false
+}
+
+// Move the entry of the key to the back.
+// Returns false if the key is not included.
+func (a *LinkedDict[K, V]) MoveToBack(key K) bool {
+	if node, ok := a.dict.At(key).Val(); ok {
+		a.list.MoveToBack(node)
+		return true
+	}
+	return false
+}
+
+// Clears all entries.
+func (a *LinkedDict[K, V]) Clear() {
+	a.dict.Clear()
+	a.list.Clear()
+}
+
+// Return the Iterator of dict, from the front to the back, it implements seq.MutableIterator.
+func (a *LinkedDict[K, V]) Iterator() seq.Iterator[Entry[K, V]] {
+	return &linkedDictIterator[K, V]{iterator: a.list.Iterator().(seq.MutableIterator[Entry[K, V]]), source: a}
+}
+
+type linkedDictIterator[K comparable, V any] struct {
+	iterator seq.MutableIterator[Entry[K, V]]
+	source   *LinkedDict[K, V]
+	lastKey  K
+}
+
+func (a *linkedDictIterator[K, V]) Next() option.Option[Entry[K, V]] {
+	var next = a.iterator.Next()
+	if v, ok := next.Val(); ok {
+		a.lastKey = v.Key
+	}
+	return next
+}
+
+func (a *linkedDictIterator[K, V]) Remove() {
+	a.iterator.Remove()
+	a.source.dict.Remove(a.lastKey)
+}
+
+// Return a Sequence of the entries from the back to the front.
+func (a *LinkedDict[K, V]) Reversed() seq.Sequence[Entry[K, V]] {
+	return linkedDictReversed[K, V]{a}
+}
+
+// Return a new dict that copies all entries in the same order.
+func (a *LinkedDict[K, V]) Clone() *LinkedDict[K, V] {
+	var dict = MakeLinkedWithEqualer[K, V](a.dict.hash, a.dict.equal, a.Count())
+	dict.accessOrder = a.accessOrder
+	seq.ForEach[Entry[K, V]](func(t Entry[K, V]) {
+		dict.Add(t.Key, t.Value)
+	}, a)
+	return dict
+}
+
+type linkedDictReversed[K comparable, V any] struct {
+	source *LinkedDict[K, V]
+}
+
+func (a linkedDictReversed[K, V]) Iterator() seq.Iterator[Entry[K, V]] {
+	return a.source.list.Reversed().Iterator()
+}
+
+func LinkedCollector[K comparable, V any]() seq.Collector[*LinkedDict[K, V], Entry[K, V], *LinkedDict[K, V]] {
+	return linkedCollector[K, V]{}
+}
+
+type linkedCollector[K comparable, V any] struct{}
+
+func (a linkedCollector[K, V]) Builder() *LinkedDict[K, V] {
+	return MakeLinked[K, V](10)
+}
+
+func (a linkedCollector[K, V]) Append(supplier *LinkedDict[K, V], element Entry[K, V]) {
+	supplier.Add(element.Key, element.Value)
+}
+
+func (a linkedCollector[K, V]) Finish(supplier *LinkedDict[K, V]) *LinkedDict[K, V] {
+	return supplier
+}
@@ -0,0 +1,75 @@
+//go:build go1.23
+
+package dict
+
+import (
+	"iter"
+
+	"github.com/kulics/gollection/seq"
+)
+
+// Return an iter.Seq2 of the keys and values of dict, which can be used in range-over-func loops.
+// Like Iterator, it panics with seq.ConcurrentModification when entries are added or removed during the loop.
+func (a *Dict[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var modCount = a.modCount
+		for i := 0; i < len(a.entries); i++ {
+			a.checkModCount(modCount)
+			if item := a.entries[i]; a.full(i) {
+				if !yield(item.key, item.value) {
+					return
+				}
+			}
+		}
+		a.checkModCount(modCount)
+	}
+}
+
+// Return an iter.Seq of the keys of dict.
+func (a *Dict[K, V]) Keys() iter.Seq[K] {
+	return a.KeySet().All()
+}
+
+// Return an iter.Seq of the values of dict.
+func (a *Dict[K, V]) Values() iter.Seq[V] {
+	return a.ValueCollection().All()
+}
+
+// Return an iter.Seq of the keys of view.
+func (a KeySet[K, V]) All() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range a.source.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Return an iter.Seq of the values of view.
+func (a ValueCollection[K, V]) All() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range a.source.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Return an iter.Seq2 of the keys and values of dict in order, which can be used in range-over-func loops.
+func (a *LinkedDict[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := range a.list.Values() {
+			if !yield(e.Key, e.Value) {
+				return
+			}
+		}
+	}
+}
+
+func (a *Dict[K, V]) checkModCount(modCount int) {
+	if a.modCount != modCount {
+		panic(seq.ConcurrentModification)
+	}
+}
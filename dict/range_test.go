@@ -0,0 +1,65 @@
+//go:build go1.23
+
+package dict
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/kulics/gollection/internal/panics"
+	"github.com/kulics/gollection/seq"
+)
+
+func TestHashDictRange(t *testing.T) {
+	var dict = Of(Entry[string, int]{"a", 1}, Entry[string, int]{"b", 2})
+	var m = maps.Collect(dict.All())
+	if len(m) != 2 || m["a"] != 1 || m["b"] != 2 {
+		t.Fatal("dict all error")
+	}
+	var count = 0
+	for k := range dict.Keys() {
+		if !dict.Contains(k) {
+			t.Fatal("dict keys error")
+		}
+		count++
+	}
+	if count != 2 {
+		t.Fatal("dict keys count not eq 2")
+	}
+	var sum = 0
+	for v := range dict.Values() {
+		sum += v
+	}
+	if sum != 3 {
+		t.Fatal("dict values sum not eq 3")
+	}
+	var ints = Make[int, int](0)
+	for i := 0; i < 100; i++ {
+		ints.Add(i, i)
+	}
+	var grow = func() {
+		for k := range ints.Keys() {
+			ints.Add(k+100, k)
+		}
+	}
+	if panics.Recover(grow) != seq.ConcurrentModification {
+		t.Fatal("ints range fail fast error")
+	}
+	var update = func() {
+		for k, v := range ints.All() {
+			ints.Add(k, v+1)
+		}
+	}
+	if panics.Recover(update) != nil {
+		t.Fatal("ints range update error")
+	}
+	var linked = OfLinked(Entry[int, int]{1, 1}, Entry[int, int]{2, 2})
+	var remove = func() {
+		for k := range linked.All() {
+			linked.Remove(k)
+		}
+	}
+	if panics.Recover(remove) != seq.ConcurrentModification {
+		t.Fatal("linked ints range fail fast error")
+	}
+}
@@ -0,0 +1,92 @@
+package dict
+
+import (
+	"github.com/kulics/gollection/option"
+	"github.com/kulics/gollection/seq"
+)
+
+// Constructing a Dict from the keys, the value of each key is created by the function.
+func FromKeys[K comparable, V any](keys seq.Collection[K], value func(K) V) *Dict[K, V] {
+	var dict = Make[K, V](keys.Count())
+	seq.ForEach[K](func(k K) {
+		dict.Add(k, value(k))
+	}, keys)
+	return dict
+}
+
+// Converts the keys of dict to a Slice.
+func ToKeySlice[K comparable, V any](d *Dict[K, V]) seq.Slice[K] {
+	return seq.ToSlice[K](d.KeySet())
+}
+
+// Converts the values of dict to a Slice.
+func ToValueSlice[K comparable, V any](d *Dict[K, V]) seq.Slice[V] {
+	return seq.ToSlice[V](d.ValueCollection())
+}
+
+// Return a view of the keys of dict, it reflects the changes of dict without copying.
+func (a *Dict[K, V]) KeySet() KeySet[K, V] {
+	return KeySet[K, V]{a}
+}
+
+// Return a view of the values of dict, it reflects the changes of dict without copying.
+func (a *Dict[K, V]) ValueCollection() ValueCollection[K, V] {
+	return ValueCollection[K, V]{a}
+}
+
+// KeySet is a Collection of the keys of Dict.
+type KeySet[K comparable, V any] struct {
+	source *Dict[K, V]
+}
+
+// Return the number of keys.
+func (a KeySet[K, V]) Count() int {
+	return a.source.Count()
+}
+
+// Returns true if the key is included in the dict.
+func (a KeySet[K, V]) Contains(key K) bool {
+	return a.source.Contains(key)
+}
+
+// Return the Iterator of keys, it implements seq.MutableIterator.
+func (a KeySet[K, V]) Iterator() seq.Iterator[K] {
+	return &keysIterator[K, V]{a.source.slotIterator()}
+}
+
+// ValueCollection is a Collection of the values of Dict.
+type ValueCollection[K comparable, V any] struct {
+	source *Dict[K, V]
+}
+
+// Return the number of values.
+func (a ValueCollection[K, V]) Count() int {
+	return a.source.Count()
+}
+
+// Return the Iterator of values, it implements seq.MutableIterator.
+func (a ValueCollection[K, V]) Iterator() seq.Iterator[V] {
+	return &valuesIterator[K, V]{a.source.slotIterator()}
+}
+
+type keysIterator[K comparable, V any] struct {
+	slotIterator[K, V]
+}
+
+func (a *keysIterator[K, V]) Next() option.Option[K] {
+	if a.advance() {
+		return option.Some(a.source.entries[a.index].key)
+	}
+	return option.None[K]()
+}
+
+type valuesIterator[K comparable, V any] struct {
+	slotIterator[K, V]
+}
+
+func (a *valuesIterator[K, V]) Next() option.Option[V] {
+	if a.advance() {
+		return option.Some(a.source.entries[a.index].value)
+	}
+	return option.None[V]()
+}
@@ -0,0 +1,12 @@
+//go:build go1.24
+
+package hash
+
+import "hash/maphash"
+
+func comparableHasher[T comparable]() Hasher[T] {
+	var seed = maphash.MakeSeed()
+	return HasherFunc[T](func(value T) uint64 {
+		return maphash.Comparable(seed, value)
+	})
+}
@@ -0,0 +1,9 @@
+//go:build !go1.24
+
+package hash
+
+import "reflect"
+
+func comparableHasher[T comparable]() Hasher[T] {
+	panic("hash: no default Hasher of " + reflect.TypeOf((*T)(nil)).Elem().String() + ", build one with By, Combine and Array")
+}
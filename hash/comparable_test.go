@@ -0,0 +1,18 @@
+//go:build go1.24
+
+package hash
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDefaultInterface(t *testing.T) {
+	var a = strings.Repeat("ab", 3)
+	var b = strings.Repeat("a", 1) + "babab"
+	var interfaces = Default[any]()
+	if interfaces.Hash(a) != interfaces.Hash(b) || interfaces.Hash(1) == interfaces.Hash(int64(1)) ||
+		interfaces.Hash(nil) != interfaces.Hash(nil) {
+		t.Fatal("default interface error")
+	}
+}
@@ -0,0 +1,80 @@
+package hash
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+var hashableType = reflect.TypeOf((*Hashable)(nil)).Elem()
+
+// Return the Hasher consistent with the == operator of T.
+// The types implementing Hashable are hashed by their Hash method, the scalar kinds and strings are hashed directly,
+// other kinds such as structs, arrays and interfaces are hashed by maphash.Comparable, which requires Go 1.24,
+// the interfaces are hashed with their dynamic types,
+// with older versions they need a Hasher built with By, Combine and Array.
+// The kind of T is only inspected once here, the Hasher does not use reflection.
+// It is safe for concurrent use.
+func Default[T comparable]() Hasher[T] {
+	var seed = newSeed()
+	var t = reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Interface && t.Implements(hashableType) {
+		return HasherFunc[T](func(value T) uint64 {
+			return any(value).(Hashable).Hash()
+		})
+	}
+	switch t.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		// The memory of these kinds has no padding and equal values have equal bits.
+		switch t.Size() {
+		case 1:
+			return HasherFunc[T](func(value T) uint64 {
+				return mix(seed, uint64(*(*uint8)(unsafe.Pointer(&value))))
+			})
+		case 2:
+			return HasherFunc[T](func(value T) uint64 {
+				return mix(seed, uint64(*(*uint16)(unsafe.Pointer(&value))))
+			})
+		case 4:
+			return HasherFunc[T](func(value T) uint64 {
+				return mix(seed, uint64(*(*uint32)(unsafe.Pointer(&value))))
+			})
+		default:
+			return HasherFunc[T](func(value T) uint64 {
+				return mix(seed, *(*uint64)(unsafe.Pointer(&value)))
+			})
+		}
+	case reflect.Float32:
+		return HasherFunc[T](func(value T) uint64 {
+			return mix(seed, floatBits(float64(*(*float32)(unsafe.Pointer(&value)))))
+		})
+	case reflect.Float64:
+		return HasherFunc[T](func(value T) uint64 {
+			return mix(seed, floatBits(*(*float64)(unsafe.Pointer(&value))))
+		})
+	case reflect.Complex64:
+		return HasherFunc[T](func(value T) uint64 {
+			var c = *(*complex64)(unsafe.Pointer(&value))
+			return mix(mix(seed, floatBits(float64(real(c)))), floatBits(float64(imag(c))))
+		})
+	case reflect.Complex128:
+		return HasherFunc[T](func(value T) uint64 {
+			var c = *(*complex128)(unsafe.Pointer(&value))
+			return mix(mix(seed, floatBits(real(c))), floatBits(imag(c)))
+		})
+	case reflect.String:
+		var stringHasher = String[string]()
+		return HasherFunc[T](func(value T) uint64 {
+			return stringHasher.Hash(*(*string)(unsafe.Pointer(&value)))
+		})
+	case reflect.Interface:
+		var hasher = comparableHasher[T]()
+		return HasherFunc[T](func(value T) uint64 {
+			// The first word of an interface value identifies its dynamic type.
+			return mix(hasher.Hash(value), uint64(*(*uintptr)(unsafe.Pointer(&value))))
+		})
+	default:
+		return comparableHasher[T]()
+	}
+}
@@ -0,0 +1,188 @@
+package hash
+
+import (
+	"hash/maphash"
+	"math"
+	"math/bits"
+	"unsafe"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Hasher computes the hash of values, equal values must have the same hash.
+type Hasher[T any] interface {
+	Hash(value T) uint64
+}
+
+// Equaler reports whether two values are equal.
+type Equaler[T any] interface {
+	Equal(l T, r T) bool
+}
+
+// Hashable is implemented by the types that provide their own hash.
+type Hashable interface {
+	Hash() uint64
+}
+
+// Equatable is implemented by the types that provide their own equality.
+type Equatable[T any] interface {
+	Equal(other T) bool
+}
+
+// HasherFunc is an adapter to use an ordinary function as Hasher.
+type HasherFunc[T any] func(value T) uint64
+
+func (a HasherFunc[T]) Hash(value T) uint64 {
+	return a(value)
+}
+
+// EqualerFunc is an adapter to use an ordinary function as Equaler.
+type EqualerFunc[T any] func(l T, r T) bool
+
+func (a EqualerFunc[T]) Equal(l T, r T) bool {
+	return a(l, r)
+}
+
+// Return the Equaler using the == operator.
+func Equal[T comparable]() Equaler[T] {
+	return EqualerFunc[T](func(l T, r T) bool {
+		return l == r
+	})
+}
+
+// Return the Hasher using the Hash method of values.
+func HashMethod[T Hashable]() Hasher[T] {
+	return HasherFunc[T](func(value T) uint64 {
+		return value.Hash()
+	})
+}
+
+// Return the Equaler using the Equal method of values.
+func EqualMethod[T Equatable[T]]() Equaler[T] {
+	return EqualerFunc[T](func(l T, r T) bool {
+		return l.Equal(r)
+	})
+}
+
+// Return the Hasher of integers.
+func Integer[T constraints.Integer]() Hasher[T] {
+	var seed = newSeed()
+	return HasherFunc[T](func(value T) uint64 {
+		return mix(seed, uint64(value))
+	})
+}
+
+// Return the Hasher of floats, +0 and -0 have the same hash, and so do all NaNs.
+func Float[T constraints.Float]() Hasher[T] {
+	var seed = newSeed()
+	return HasherFunc[T](func(value T) uint64 {
+		return mix(seed, floatBits(float64(value)))
+	})
+}
+
+// Return the Hasher of complex numbers, the parts are hashed as Float.
+func Complex[T constraints.Complex]() Hasher[T] {
+	var seed = newSeed()
+	return HasherFunc[T](func(value T) uint64 {
+		var c = complex128(value)
+		return mix(mix(seed, floatBits(real(c))), floatBits(imag(c)))
+	})
+}
+
+// Return the Hasher of bools.
+func Bool[T ~bool]() Hasher[T] {
+	var seed = newSeed()
+	return HasherFunc[T](func(value T) uint64 {
+		if value {
+			return mix(seed, 1)
+		}
+		return mix(seed, 0)
+	})
+}
+
+// Return the Hasher of strings.
+func String[T ~string]() Hasher[T] {
+	var seed = maphash.MakeSeed()
+	return HasherFunc[T](func(value T) uint64 {
+		var h maphash.Hash
+		h.SetSeed(seed)
+		h.WriteString(string(value))
+		return h.Sum64()
+	})
+}
+
+// Return the Hasher of pointers, the pointers are hashed by address.
+func Pointer[T any]() Hasher[*T] {
+	var seed = newSeed()
+	return HasherFunc[*T](func(value *T) uint64 {
+		return mix(seed, uint64(uintptr(unsafe.Pointer(value))))
+	})
+}
+
+// Return the Hasher of slices, the elements are hashed by element in order.
+func Slice[T any](element Hasher[T]) Hasher[[]T] {
+	var seed = newSeed()
+	return HasherFunc[[]T](func(value []T) uint64 {
+		var h = mix(seed, uint64(len(value)))
+		for _, v := range value {
+			h = mix(h, element.Hash(v))
+		}
+		return h
+	})
+}
+
+// Return the Hasher of arrays, elements return the elements of an array as a slice.
+func Array[A any, T any](elements func(*A) []T, element Hasher[T]) Hasher[A] {
+	var slice = Slice(element)
+	return HasherFunc[A](func(value A) uint64 {
+		return slice.Hash(elements(&value))
+	})
+}
+
+// Return the Hasher that hashes the part of values selected by key.
+func By[T any, K any](key func(T) K, hasher Hasher[K]) Hasher[T] {
+	return HasherFunc[T](func(value T) uint64 {
+		return hasher.Hash(key(value))
+	})
+}
+
+// Return the Hasher that combines the hashes of hashers in order, it is used to hash structs by fields.
+func Combine[T any](hashers ...Hasher[T]) Hasher[T] {
+	var seed = newSeed()
+	return HasherFunc[T](func(value T) uint64 {
+		var h = seed
+		for _, v := range hashers {
+			h = mix(h, v.Hash(value))
+		}
+		return h
+	})
+}
+
+// Return the Equaler that compares the part of values selected by key.
+func EqualBy[T any, K comparable](key func(T) K) Equaler[T] {
+	return EqualerFunc[T](func(l T, r T) bool {
+		return key(l) == key(r)
+	})
+}
+
+func newSeed() uint64 {
+	// The zero Hash uses a random seed.
+	var h maphash.Hash
+	return h.Sum64()
+}
+
+func mix(seed uint64, value uint64) uint64 {
+	var hi, lo = bits.Mul64(seed^value^0xa0761d6478bd642f, 0xe7037ed1a0b428db)
+	return hi ^ lo
+}
+
+func floatBits(value float64) uint64 {
+	switch {
+	case value == 0:
+		return 0
+	case value != value:
+		return math.Float64bits(math.NaN())
+	default:
+		return math.Float64bits(value)
+	}
+}
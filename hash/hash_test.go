@@ -0,0 +1,89 @@
+package hash
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+type point struct {
+	x    int
+	name string
+	_    int
+}
+
+type id struct {
+	value int
+}
+
+func (a id) Hash() uint64 {
+	return uint64(a.value)
+}
+
+func (a id) Equal(other id) bool {
+	return a.value == other.value
+}
+
+func TestDefault(t *testing.T) {
+	var ints = Default[int]()
+	if ints.Hash(1) != ints.Hash(1) || ints.Hash(1) == ints.Hash(2) {
+		t.Fatal("default int error")
+	}
+	var floats = Default[float64]()
+	if floats.Hash(0) != floats.Hash(math.Copysign(0, -1)) || floats.Hash(math.NaN()) != floats.Hash(-math.NaN()) {
+		t.Fatal("default float error")
+	}
+	var a = strings.Repeat("ab", 3)
+	var b = strings.Repeat("a", 1) + "babab"
+	var points = Default[point]()
+	if points.Hash(point{x: 1, name: a}) != points.Hash(point{x: 1, name: b}) {
+		t.Fatal("default struct error")
+	}
+	if points.Hash(point{x: 1, name: a}) == points.Hash(point{x: 1, name: "other"}) {
+		t.Fatal("default struct field error")
+	}
+	var arrays = Default[[2]string]()
+	if arrays.Hash([2]string{a, "c"}) != arrays.Hash([2]string{b, "c"}) {
+		t.Fatal("default array error")
+	}
+	var pointers = Default[*int]()
+	var x, y = 1, 1
+	if pointers.Hash(&x) != pointers.Hash(&x) || pointers.Hash(&x) == pointers.Hash(&y) {
+		t.Fatal("default pointer error")
+	}
+	if Default[id]().Hash(id{3}) != 3 {
+		t.Fatal("default hashable error")
+	}
+}
+
+func TestCombinators(t *testing.T) {
+	var a = strings.Repeat("ab", 3)
+	var b = strings.Repeat("a", 1) + "babab"
+	var points = Combine(
+		By(func(p point) int { return p.x }, Integer[int]()),
+		By(func(p point) string { return p.name }, String[string]()),
+	)
+	if points.Hash(point{x: 1, name: a}) != points.Hash(point{x: 1, name: b}) ||
+		points.Hash(point{x: 1, name: a}) == points.Hash(point{x: 2, name: a}) {
+		t.Fatal("combine error")
+	}
+	var arrays = Array(func(a *[3]float32) []float32 { return a[:] }, Float[float32]())
+	if arrays.Hash([3]float32{0, 1, 2}) != arrays.Hash([3]float32{float32(math.Copysign(0, -1)), 1, 2}) {
+		t.Fatal("array error")
+	}
+	var slices = Slice(Bool[bool]())
+	if slices.Hash([]bool{true, false}) == slices.Hash([]bool{false, true}) {
+		t.Fatal("slice error")
+	}
+	var complexes = Complex[complex128]()
+	if complexes.Hash(complex(0, 1)) != complexes.Hash(complex(math.Copysign(0, -1), 1)) {
+		t.Fatal("complex error")
+	}
+	if HashMethod[id]().Hash(id{3}) != 3 || !EqualMethod[id]().Equal(id{3}, id{3}) {
+		t.Fatal("method error")
+	}
+	var insensitive = EqualBy(strings.ToLower)
+	if !insensitive.Equal("Go", "gO") || insensitive.Equal("go", "java") || !Equal[int]().Equal(1, 1) {
+		t.Fatal("equaler error")
+	}
+}
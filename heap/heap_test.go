@@ -0,0 +1,156 @@
+package heap
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/kulics/gollection/internal/panics"
+	"github.com/kulics/gollection/seq"
+	"golang.org/x/exp/slices"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	var queue = Of[int]()
+	if queue.Count() != 0 {
+		t.Fatal("queue count not eq 0")
+	}
+	if queue.Pop().IsSome() || queue.Peek().IsSome() {
+		t.Fatal("queue must has not element")
+	}
+	var random = rand.New(rand.NewSource(1))
+	var datas = make([]int, 100)
+	for i := range datas {
+		datas[i] = random.Intn(50)
+		queue.Push(datas[i])
+	}
+	slices.Sort(datas)
+	if queue.Peek().OrPanic() != datas[0] {
+		t.Fatal("queue peek error")
+	}
+	if !seq.Equals[int](seq.ToSlice[int](queue), seq.Slice[int](datas)) {
+		t.Fatal("queue iterator order error")
+	}
+	if queue.Count() != 100 {
+		t.Fatal("queue iterator modified queue")
+	}
+	var heapified = From[int](seq.Slice[int]([]int{5, 3, 8, 1, 9, 2}))
+	for _, v := range []int{1, 2, 3, 5, 8, 9} {
+		if heapified.Pop().OrPanic() != v {
+			t.Fatal("queue from error")
+		}
+	}
+	for _, v := range datas {
+		if queue.Pop().OrPanic() != v {
+			t.Fatal("queue pop order error")
+		}
+	}
+	var maxQueue = MakeWithLess(func(a, b string) bool {
+		return a > b
+	}, 0)
+	maxQueue.Push("a")
+	maxQueue.Push("c")
+	maxQueue.Push("b")
+	if maxQueue.Pop().OrPanic() != "c" {
+		t.Fatal("queue less error")
+	}
+	var iter = maxQueue.Iterator()
+	iter.Next()
+	maxQueue.Push("d")
+	if panics.Recover(func() { iter.Next() }) != seq.ConcurrentModification {
+		t.Fatal("queue fail fast error")
+	}
+}
+
+func TestIndexedPriorityQueue(t *testing.T) {
+	var queue = MakeIndexed[int](0)
+	var handles = make([]*Handle[int], 10)
+	for i := range handles {
+		handles[i] = queue.Push(i * 10)
+	}
+	if !queue.Update(handles[5], -1) || queue.Peek().OrPanic() != -1 {
+		t.Fatal("queue update decrease error")
+	}
+	if !queue.Update(handles[0], 100) {
+		t.Fatal("queue update increase error")
+	}
+	if queue.Remove(handles[3]).OrPanic() != 30 || queue.Remove(handles[3]).IsSome() {
+		t.Fatal("queue remove error")
+	}
+	if queue.Contains(handles[3]) || queue.Update(handles[3], 0) {
+		t.Fatal("queue contains removed handle")
+	}
+	var expect = []int{-1, 10, 20, 40, 60, 70, 80, 90, 100}
+	if !seq.Equals[int](seq.ToSlice[int](queue), seq.Slice[int](expect)) {
+		t.Fatal("queue iterator order error")
+	}
+	for _, v := range expect {
+		if queue.Pop().OrPanic() != v {
+			t.Fatal("queue pop order error")
+		}
+	}
+	if queue.Count() != 0 || queue.Contains(handles[0]) {
+		t.Fatal("queue must has not element")
+	}
+	var indexed = MakeIndexed[int](0)
+	var handle = indexed.Push(1)
+	indexed.Push(2)
+	var indexedIter = indexed.Iterator()
+	indexed.Update(handle, 3)
+	if panics.Recover(func() { indexedIter.Next() }) != seq.ConcurrentModification {
+		t.Fatal("indexed queue fail fast error")
+	}
+}
+
+func TestMinMaxHeap(t *testing.T) {
+	var heap = MakeMinMax[int](0)
+	if heap.PopMin().IsSome() || heap.PeekMax().IsSome() {
+		t.Fatal("heap must has not element")
+	}
+	var random = rand.New(rand.NewSource(2))
+	var datas = make([]int, 0)
+	for i := 0; i < 500; i++ {
+		if len(datas) > 0 && random.Intn(3) == 0 {
+			slices.Sort(datas)
+			if random.Intn(2) == 0 {
+				if heap.PopMin().OrPanic() != datas[0] {
+					t.Fatal("heap pop min error")
+				}
+				datas = datas[1:]
+			} else {
+				if heap.PopMax().OrPanic() != datas[len(datas)-1] {
+					t.Fatal("heap pop max error")
+				}
+				datas = datas[:len(datas)-1]
+			}
+		} else {
+			var v = random.Intn(100)
+			datas = append(datas, v)
+			heap.Push(v)
+		}
+		if heap.Count() != len(datas) {
+			t.Fatal("heap count error")
+		}
+	}
+	slices.Sort(datas)
+	if heap.PeekMin().OrPanic() != datas[0] || heap.PeekMax().OrPanic() != datas[len(datas)-1] {
+		t.Fatal("heap peek error")
+	}
+	if !seq.Equals[int](seq.ToSlice[int](heap), seq.Slice[int](datas)) {
+		t.Fatal("heap iterator order error")
+	}
+	var shuffled = slices.Clone(datas)
+	random.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	var built = FromMinMax[int](seq.Slice[int](shuffled))
+	for i := len(datas) - 1; i >= 0; i-- {
+		if built.PopMax().OrPanic() != datas[i] {
+			t.Fatal("heap from error")
+		}
+	}
+	var builtIter = built.Iterator()
+	built.Push(1)
+	if panics.Recover(func() { builtIter.Next() }) != seq.ConcurrentModification {
+		t.Fatal("min max heap fail fast error")
+	}
+}
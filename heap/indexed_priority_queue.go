@@ -0,0 +1,202 @@
+package heap
+
+import (
+	"github.com/kulics/gollection/option"
+	"github.com/kulics/gollection/seq"
+	"golang.org/x/exp/constraints"
+)
+
+// Constructing an empty IndexedPriorityQueue with capacity, the least element has the highest priority.
+func MakeIndexed[T constraints.Ordered](capacity int) *IndexedPriorityQueue[T] {
+	return MakeIndexedWithLess(less[T], capacity)
+}
+
+// Constructing an empty IndexedPriorityQueue with capacity,
+// the element that is less than others has the highest priority.
+func MakeIndexedWithLess[T any](less func(a, b T) bool, capacity int) *IndexedPriorityQueue[T] {
+	if capacity < defaultElementsLength {
+		capacity = defaultElementsLength
+	}
+	return &IndexedPriorityQueue[T]{handles: make([]*Handle[T], 0, capacity), less: less}
+}
+
+// Handle refers to an element in the IndexedPriorityQueue, it can be used to update or remove the element.
+type Handle[T any] struct {
+	value T
+	index int
+	owner *IndexedPriorityQueue[T]
+}
+
+// Return the element of handle.
+func (a *Handle[T]) Value() T {
+	return a.value
+}
+
+// IndexedPriorityQueue implemented using binary heap,
+// the position of each element is tracked by its Handle to support update and remove.
+// The iterators panic with seq.ConcurrentModification when queue is modified during iteration.
+type IndexedPriorityQueue[T any] struct {
+	handles  []*Handle[T]
+	less     func(T, T) bool
+	modCount int
+}
+
+// Return the number of elements of queue.
+func (a *IndexedPriorityQueue[T]) Count() int {
+	return len(a.handles)
+}
+
+// Add an element to the queue, return the Handle of the element.
+func (a *IndexedPriorityQueue[T]) Push(element T) *Handle[T] {
+	var handle = &Handle[T]{element, len(a.handles), a}
+	a.handles = append(a.handles, handle)
+	a.modCount++
+	a.up(handle.index)
+	return handle
+}
+
+// Remove the element with the highest priority.
+// Return None when the queue is empty.
+func (a *IndexedPriorityQueue[T]) Pop() option.Option[T] {
+	if len(a.handles) == 0 {
+		return option.None[T]()
+	}
+	return option.Some(a.removeAt(0))
+}
+
+// Return the element with the highest priority, but does not remove it.
+// Return None when the queue is empty.
+func (a *IndexedPriorityQueue[T]) Peek() option.Option[T] {
+	if len(a.handles) == 0 {
+		return option.None[T]()
+	}
+	return option.Some(a.handles[0].value)
+}
+
+// Returns true if the element of handle is in the queue.
+func (a *IndexedPriorityQueue[T]) Contains(handle *Handle[T]) bool {
+	return handle.owner == a && handle.index >= 0
+}
+
+// Replace the element of handle and restore the order of queue.
+// Returns false if the element of handle is not in the queue.
+func (a *IndexedPriorityQueue[T]) Update(handle *Handle[T], element T) bool {
+	if !a.Contains(handle) {
+		return false
+	}
+	handle.value = element
+	a.modCount++
+	a.fix(handle.index)
+	return true
+}
+
+// Remove the element of handle.
+// Return None if the element of handle is not in the queue.
+func (a *IndexedPriorityQueue[T]) Remove(handle *Handle[T]) option.Option[T] {
+	if !a.Contains(handle) {
+		return option.None[T]()
+	}
+	return option.Some(a.removeAt(handle.index))
+}
+
+// Clears all elements.
+func (a *IndexedPriorityQueue[T]) Clear() {
+	for i, v := range a.handles {
+		v.index = -1
+		a.handles[i] = nil
+	}
+	a.handles = a.handles[:0]
+	a.modCount++
+}
+
+// Return the Iterator of queue, the elements are in priority order.
+// It does not modify the queue.
+func (a *IndexedPriorityQueue[T]) Iterator() seq.Iterator[T] {
+	var indexes = MakeWithLess(func(i, j int) bool {
+		return a.less(a.handles[i].value, a.handles[j].value)
+	}, 0)
+	if len(a.handles) > 0 {
+		indexes.Push(0)
+	}
+	return &indexedIterator[T]{indexes, a, a.modCount}
+}
+
+func (a *IndexedPriorityQueue[T]) removeAt(index int) T {
+	var handle = a.handles[index]
+	var last = len(a.handles) - 1
+	if index != last {
+		a.swap(index, last)
+	}
+	a.handles[last] = nil
+	a.handles = a.handles[:last]
+	a.modCount++
+	if index != last {
+		a.fix(index)
+	}
+	handle.index = -1
+	return handle.value
+}
+
+func (a *IndexedPriorityQueue[T]) fix(index int) {
+	if !a.down(index) {
+		a.up(index)
+	}
+}
+
+func (a *IndexedPriorityQueue[T]) swap(i, j int) {
+	a.handles[i], a.handles[j] = a.handles[j], a.handles[i]
+	a.handles[i].index = i
+	a.handles[j].index = j
+}
+
+func (a *IndexedPriorityQueue[T]) up(index int) {
+	for index > 0 {
+		var parent = (index - 1) / 2
+		if !a.less(a.handles[index].value, a.handles[parent].value) {
+			break
+		}
+		a.swap(index, parent)
+		index = parent
+	}
+}
+
+func (a *IndexedPriorityQueue[T]) down(index int) bool {
+	var start = index
+	for {
+		var left = 2*index + 1
+		if left >= len(a.handles) {
+			break
+		}
+		var child = left
+		if right := left + 1; right < len(a.handles) && a.less(a.handles[right].value, a.handles[left].value) {
+			child = right
+		}
+		if !a.less(a.handles[child].value, a.handles[index].value) {
+			break
+		}
+		a.swap(index, child)
+		index = child
+	}
+	return index > start
+}
+
+type indexedIterator[T any] struct {
+	indexes  *PriorityQueue[int]
+	source   *IndexedPriorityQueue[T]
+	modCount int
+}
+
+func (a *indexedIterator[T]) Next() option.Option[T] {
+	if a.modCount != a.source.modCount {
+		panic(seq.ConcurrentModification)
+	}
+	if index, ok := a.indexes.Pop().Val(); ok {
+		for _, child := range [2]int{2*index + 1, 2*index + 2} {
+			if child < len(a.source.handles) {
+				a.indexes.Push(child)
+			}
+		}
+		return option.Some(a.source.handles[index].value)
+	}
+	return option.None[T]()
+}
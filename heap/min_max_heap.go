@@ -0,0 +1,227 @@
+package heap
+
+import (
+	"math/bits"
+
+	"github.com/kulics/gollection/option"
+	"github.com/kulics/gollection/seq"
+	"golang.org/x/exp/constraints"
+)
+
+// Constructing an empty MinMaxHeap with capacity.
+func MakeMinMax[T constraints.Ordered](capacity int) *MinMaxHeap[T] {
+	return MakeMinMaxWithLess(less[T], capacity)
+}
+
+// Constructing an empty MinMaxHeap with capacity, the order of elements is defined by less.
+func MakeMinMaxWithLess[T any](less func(a, b T) bool, capacity int) *MinMaxHeap[T] {
+	if capacity < defaultElementsLength {
+		capacity = defaultElementsLength
+	}
+	return &MinMaxHeap[T]{elements: make([]T, 0, capacity), less: less}
+}
+
+// Constructing a MinMaxHeap from other Collection in linear time.
+func FromMinMax[T constraints.Ordered](collection seq.Collection[T]) *MinMaxHeap[T] {
+	var heap = MakeMinMax[T](collection.Count())
+	heap.elements = append(heap.elements, seq.ToSlice(collection)...)
+	for i := len(heap.elements)/2 - 1; i >= 0; i-- {
+		heap.down(i)
+	}
+	return heap
+}
+
+// MinMaxHeap implemented using min-max heap,
+// both the least and the greatest element can be accessed in constant time and removed in logarithmic time.
+type MinMaxHeap[T any] struct {
+	elements []T
+	less     func(T, T) bool
+	modCount int
+}
+
+// Return the number of elements of heap.
+func (a *MinMaxHeap[T]) Count() int {
+	return len(a.elements)
+}
+
+// Add an element to the heap.
+func (a *MinMaxHeap[T]) Push(element T) {
+	a.elements = append(a.elements, element)
+	a.modCount++
+	a.up(len(a.elements) - 1)
+}
+
+// Return the least element, but does not remove it.
+// Return None when the heap is empty.
+func (a *MinMaxHeap[T]) PeekMin() option.Option[T] {
+	if len(a.elements) == 0 {
+		return option.None[T]()
+	}
+	return option.Some(a.elements[0])
+}
+
+// Return the greatest element, but does not remove it.
+// Return None when the heap is empty.
+func (a *MinMaxHeap[T]) PeekMax() option.Option[T] {
+	if len(a.elements) == 0 {
+		return option.None[T]()
+	}
+	return option.Some(a.elements[a.maxIndex()])
+}
+
+// Remove the least element.
+// Return None when the heap is empty.
+func (a *MinMaxHeap[T]) PopMin() option.Option[T] {
+	if len(a.elements) == 0 {
+		return option.None[T]()
+	}
+	return option.Some(a.removeAt(0))
+}
+
+// Remove the greatest element.
+// Return None when the heap is empty.
+func (a *MinMaxHeap[T]) PopMax() option.Option[T] {
+	if len(a.elements) == 0 {
+		return option.None[T]()
+	}
+	return option.Some(a.removeAt(a.maxIndex()))
+}
+
+// Clears all elements, but does not reset the space.
+func (a *MinMaxHeap[T]) Clear() {
+	var emptyValue T
+	for i := range a.elements {
+		a.elements[i] = emptyValue
+	}
+	a.elements = a.elements[:0]
+	a.modCount++
+}
+
+// Return the Iterator of heap, the elements are in ascending order.
+// It iterates a copy of the heap, so it does not modify the heap,
+// and it panics with seq.ConcurrentModification when the heap is modified during iteration.
+func (a *MinMaxHeap[T]) Iterator() seq.Iterator[T] {
+	return &minMaxIterator[T]{a.Clone(), a, a.modCount}
+}
+
+// Return a new heap that copies all elements.
+func (a *MinMaxHeap[T]) Clone() *MinMaxHeap[T] {
+	var elements = make([]T, len(a.elements), cap(a.elements))
+	copy(elements, a.elements)
+	return &MinMaxHeap[T]{elements: elements, less: a.less}
+}
+
+func (a *MinMaxHeap[T]) maxIndex() int {
+	switch len(a.elements) {
+	case 1:
+		return 0
+	case 2:
+		return 1
+	default:
+		if a.less(a.elements[1], a.elements[2]) {
+			return 2
+		}
+		return 1
+	}
+}
+
+func (a *MinMaxHeap[T]) removeAt(index int) T {
+	var item = a.elements[index]
+	var last = len(a.elements) - 1
+	a.elements[index] = a.elements[last]
+	var empty T
+	a.elements[last] = empty
+	a.elements = a.elements[:last]
+	a.modCount++
+	if index < last {
+		a.down(index)
+	}
+	return item
+}
+
+func isMinLevel(index int) bool {
+	return bits.Len(uint(index+1))%2 == 1
+}
+
+// Returns true if x has higher priority than y on the level, which means less on min levels and greater on max levels.
+func (a *MinMaxHeap[T]) before(minLevel bool, x, y T) bool {
+	if minLevel {
+		return a.less(x, y)
+	}
+	return a.less(y, x)
+}
+
+func (a *MinMaxHeap[T]) up(index int) {
+	if index == 0 {
+		return
+	}
+	var parent = (index - 1) / 2
+	var minLevel = isMinLevel(index)
+	if a.before(!minLevel, a.elements[index], a.elements[parent]) {
+		a.elements[index], a.elements[parent] = a.elements[parent], a.elements[index]
+		a.upLevel(!minLevel, parent)
+	} else {
+		a.upLevel(minLevel, index)
+	}
+}
+
+func (a *MinMaxHeap[T]) upLevel(minLevel bool, index int) {
+	for index > 2 {
+		var grandparent = ((index-1)/2 - 1) / 2
+		if !a.before(minLevel, a.elements[index], a.elements[grandparent]) {
+			break
+		}
+		a.elements[index], a.elements[grandparent] = a.elements[grandparent], a.elements[index]
+		index = grandparent
+	}
+}
+
+func (a *MinMaxHeap[T]) down(index int) {
+	var minLevel = isMinLevel(index)
+	var length = len(a.elements)
+	for {
+		// Find the first among the children and grandchildren.
+		var first = -1
+		for _, child := range [2]int{2*index + 1, 2*index + 2} {
+			if child >= length {
+				break
+			}
+			if first < 0 || a.before(minLevel, a.elements[child], a.elements[first]) {
+				first = child
+			}
+			for _, grandchild := range [2]int{2*child + 1, 2*child + 2} {
+				if grandchild >= length {
+					break
+				}
+				if a.before(minLevel, a.elements[grandchild], a.elements[first]) {
+					first = grandchild
+				}
+			}
+		}
+		if first < 0 || !a.before(minLevel, a.elements[first], a.elements[index]) {
+			return
+		}
+		a.elements[index], a.elements[first] = a.elements[first], a.elements[index]
+		if first <= 2*index+2 {
+			return
+		}
+		var parent = (first - 1) / 2
+		if a.before(!minLevel, a.elements[first], a.elements[parent]) {
+			a.elements[first], a.elements[parent] = a.elements[parent], a.elements[first]
+		}
+		index = first
+	}
+}
+
+type minMaxIterator[T any] struct {
+	heap     *MinMaxHeap[T]
+	source   *MinMaxHeap[T]
+	modCount int
+}
+
+func (a *minMaxIterator[T]) Next() option.Option[T] {
+	if a.modCount != a.source.modCount {
+		panic(seq.ConcurrentModification)
+	}
+	return a.heap.PopMin()
+}
@@ -0,0 +1,233 @@
+package heap
+
+import (
+	"github.com/kulics/gollection/option"
+	"github.com/kulics/gollection/seq"
+	"golang.org/x/exp/constraints"
+)
+
+const defaultElementsLength = 10
+
+func arrayGrow(length int) int {
+	var newLength = length + (length >> 1)
+	if newLength < defaultElementsLength {
+		newLength = defaultElementsLength
+	}
+	return newLength
+}
+
+func less[T constraints.Ordered](a, b T) bool {
+	return a < b
+}
+
+// Constructing a PriorityQueue with variable-length parameters, the least element has the highest priority.
+func Of[T constraints.Ordered](elements ...T) *PriorityQueue[T] {
+	return FromWithLess[T](less[T], seq.Slice[T](elements))
+}
+
+// Constructing an empty PriorityQueue with capacity, the least element has the highest priority.
+func Make[T constraints.Ordered](capacity int) *PriorityQueue[T] {
+	return MakeWithLess(less[T], capacity)
+}
+
+// Constructing an empty PriorityQueue with capacity, the element that is less than others has the highest priority.
+func MakeWithLess[T any](less func(a, b T) bool, capacity int) *PriorityQueue[T] {
+	if capacity < defaultElementsLength {
+		capacity = defaultElementsLength
+	}
+	return &PriorityQueue[T]{elements: make([]T, capacity), less: less}
+}
+
+// Constructing a PriorityQueue from other Collection in linear time, the least element has the highest priority.
+func From[T constraints.Ordered](collection seq.Collection[T]) *PriorityQueue[T] {
+	return FromWithLess(less[T], collection)
+}
+
+// Constructing a PriorityQueue from other Collection in linear time,
+// the element that is less than others has the highest priority.
+func FromWithLess[T any](less func(a, b T) bool, collection seq.Collection[T]) *PriorityQueue[T] {
+	var queue = MakeWithLess(less, collection.Count())
+	seq.ForEach(func(t T) {
+		queue.elements[queue.length] = t
+		queue.length++
+	}, seq.Sequence[T](collection))
+	for i := queue.length/2 - 1; i >= 0; i-- {
+		queue.down(i)
+	}
+	return queue
+}
+
+// PriorityQueue implemented using binary heap.
+// The iterators panic with seq.ConcurrentModification when queue is modified during iteration.
+type PriorityQueue[T any] struct {
+	elements []T
+	length   int
+	less     func(T, T) bool
+	modCount int
+}
+
+// Return the number of elements of queue.
+func (a *PriorityQueue[T]) Count() int {
+	return a.length
+}
+
+// Add an element to the queue.
+func (a *PriorityQueue[T]) Push(element T) {
+	if growLength := a.length + 1; len(a.elements) < growLength {
+		a.grow(growLength)
+	}
+	a.elements[a.length] = element
+	a.length++
+	a.modCount++
+	a.up(a.length - 1)
+}
+
+// Remove the element with the highest priority.
+// Return None when the queue is empty.
+func (a *PriorityQueue[T]) Pop() option.Option[T] {
+	if a.length == 0 {
+		return option.None[T]()
+	}
+	var item = a.elements[0]
+	var last = a.length - 1
+	a.elements[0] = a.elements[last]
+	var empty T
+	a.elements[last] = empty
+	a.length--
+	a.modCount++
+	a.down(0)
+	return option.Some(item)
+}
+
+// Return the element with the highest priority, but does not remove it.
+// Return None when the queue is empty.
+func (a *PriorityQueue[T]) Peek() option.Option[T] {
+	if a.length == 0 {
+		return option.None[T]()
+	}
+	return option.Some(a.elements[0])
+}
+
+// Ensure that queue have enough space before expansion.
+func (a *PriorityQueue[T]) Reserve(additional int) {
+	if addable := len(a.elements) - a.length; addable < additional {
+		a.grow(a.length + additional)
+	}
+}
+
+// Return the capacity of queue.
+func (a *PriorityQueue[T]) Capacity() int {
+	return len(a.elements)
+}
+
+// Clears all elements, but does not reset the space.
+func (a *PriorityQueue[T]) Clear() {
+	var emptyValue T
+	for i := 0; i < a.length; i++ {
+		a.elements[i] = emptyValue
+	}
+	a.length = 0
+	a.modCount++
+}
+
+// Return the Iterator of queue, the elements are in priority order.
+// It does not modify the queue.
+func (a *PriorityQueue[T]) Iterator() seq.Iterator[T] {
+	var indexes = MakeWithLess(func(i, j int) bool {
+		return a.less(a.elements[i], a.elements[j])
+	}, 0)
+	if a.length > 0 {
+		indexes.Push(0)
+	}
+	return &iterator[T]{indexes, a, a.modCount}
+}
+
+// Return a new queue that copies all elements.
+func (a *PriorityQueue[T]) Clone() *PriorityQueue[T] {
+	var elements = make([]T, len(a.elements))
+	copy(elements, a.elements)
+	return &PriorityQueue[T]{
+		elements: elements,
+		length:   a.length,
+		less:     a.less,
+	}
+}
+
+func (a *PriorityQueue[T]) up(index int) {
+	for index > 0 {
+		var parent = (index - 1) / 2
+		if !a.less(a.elements[index], a.elements[parent]) {
+			break
+		}
+		a.elements[index], a.elements[parent] = a.elements[parent], a.elements[index]
+		index = parent
+	}
+}
+
+func (a *PriorityQueue[T]) down(index int) {
+	for {
+		var left = 2*index + 1
+		if left >= a.length {
+			break
+		}
+		var child = left
+		if right := left + 1; right < a.length && a.less(a.elements[right], a.elements[left]) {
+			child = right
+		}
+		if !a.less(a.elements[child], a.elements[index]) {
+			break
+		}
+		a.elements[index], a.elements[child] = a.elements[child], a.elements[index]
+		index = child
+	}
+}
+
+func (a *PriorityQueue[T]) grow(minCapacity int) {
+	var newLength = arrayGrow(len(a.elements))
+	if newLength < minCapacity {
+		newLength = minCapacity
+	}
+	var newSource = make([]T, newLength)
+	copy(newSource, a.elements)
+	a.elements = newSource
+}
+
+// The iterator visits the heap as a tree, the candidates are kept in a heap of indexes.
+type iterator[T any] struct {
+	indexes  *PriorityQueue[int]
+	source   *PriorityQueue[T]
+	modCount int
+}
+
+func (a *iterator[T]) Next() option.Option[T] {
+	if a.modCount != a.source.modCount {
+		panic(seq.ConcurrentModification)
+	}
+	if index, ok := a.indexes.Pop().Val(); ok {
+		for _, child := range [2]int{2*index + 1, 2*index + 2} {
+			if child < a.source.length {
+				a.indexes.Push(child)
+			}
+		}
+		return option.Some(a.source.elements[index])
+	}
+	return option.None[T]()
+}
+
+func Collector[T constraints.Ordered]() seq.Collector[*PriorityQueue[T], T, *PriorityQueue[T]] {
+	return collector[T]{}
+}
+
+type collector[T constraints.Ordered] struct{}
+
+func (a collector[T]) Builder() *PriorityQueue[T] {
+	return Make[T](10)
+}
+
+func (a collector[T]) Append(supplier *PriorityQueue[T], element T) {
+	supplier.Push(element)
+}
+
+func (a collector[T]) Finish(supplier *PriorityQueue[T]) *PriorityQueue[T] {
+	return supplier
+}
@@ -0,0 +1,11 @@
+// Package panics helps the tests to check the panics of collections.
+package panics
+
+// Return the value recovered from the panic of f, nil if f returns normally.
+func Recover(f func()) (r any) {
+	defer func() {
+		r = recover()
+	}()
+	f()
+	return nil
+}
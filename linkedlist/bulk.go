@@ -0,0 +1,50 @@
+package list
+
+import "github.com/kulics/gollection/seq"
+
+// Remove the elements that satisfy predicate in one pass, return the number of removed elements.
+func (a *List[T]) RemoveIf(predicate func(T) bool) int {
+	var removed = 0
+	for node := a.first; node != nil; {
+		var next = node.next
+		if predicate(node.Value) {
+			a.unlink(node)
+			removed++
+		}
+		node = next
+	}
+	return removed
+}
+
+// Replace each element with the result of transform, return the number of replaced elements.
+func (a *List[T]) ReplaceAll(transform func(T) T) int {
+	for node := a.first; node != nil; node = node.next {
+		node.Value = transform(node.Value)
+	}
+	return a.length
+}
+
+// Remove the elements of list that are included in elements, return the number of removed elements.
+func RemoveAll[T comparable](list *List[T], elements seq.Collection[T]) int {
+	var contains = containsOf(elements)
+	return list.RemoveIf(contains)
+}
+
+// Remove the elements of list that are not included in elements, return the number of removed elements.
+func RetainAll[T comparable](list *List[T], elements seq.Collection[T]) int {
+	var contains = containsOf(elements)
+	return list.RemoveIf(func(t T) bool {
+		return !contains(t)
+	})
+}
+
+func containsOf[T comparable](elements seq.Collection[T]) func(T) bool {
+	var set = make(map[T]struct{}, elements.Count())
+	seq.ForEach[T](func(t T) {
+		set[t] = struct{}{}
+	}, elements)
+	return func(t T) bool {
+		var _, ok = set[t]
+		return ok
+	}
+}
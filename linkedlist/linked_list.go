@@ -7,7 +7,7 @@ import (
 )
 
 func Of[T any](elements ...T) *List[T] {
-	var list = &List[T]{0, nil, nil}
+	var list = &List[T]{}
 	for _, v := range elements {
 		list.AddLast(v)
 	}
@@ -20,10 +20,13 @@ func From[T any](collection seq.Collection[T]) *List[T] {
 	return list
 }
 
+// The iterators panic with seq.ConcurrentModification when list is structurally modified during iteration,
+// except by the Remove of the iterator itself.
 type List[T any] struct {
-	length int
-	first  *LinkedListNode[T]
-	last   *LinkedListNode[T]
+	length   int
+	first    *LinkedListNode[T]
+	last     *LinkedListNode[T]
+	modCount int
 }
 
 // Returns the element at the start.
@@ -123,6 +126,7 @@ func (a *List[T]) AddAll(index int, elements seq.Collection[T]) {
 		succ.prev = pred
 	}
 	a.length += length
+	a.modCount++
 }
 
 // Remove element at the index.
@@ -151,6 +155,7 @@ func (a *List[T]) RemoveRange(begin, end int) {
 	first.next = last
 	last.prev = first
 	a.length -= end - begin
+	a.modCount++
 }
 
 // Clears all elements.
@@ -166,6 +171,7 @@ func (a *List[T]) Clear() {
 	a.first = nil
 	a.last = nil
 	a.length = 0
+	a.modCount++
 }
 
 // Return the number of elements of list.
@@ -173,9 +179,14 @@ func (a *List[T]) Count() int {
 	return a.length
 }
 
-// Return the Iterator of list.
+// Return the Iterator of list, it implements seq.MutableIterator.
 func (a *List[T]) Iterator() seq.Iterator[T] {
-	return &linkedListIterator[T]{a.first}
+	return &linkedListIterator[T]{current: a.first, source: a, modCount: a.modCount}
+}
+
+// Return a Sequence of the elements from the back to the front, its Iterator is fail-fast like Iterator.
+func (a *List[T]) Reversed() seq.Sequence[T] {
+	return linkedListReversed[T]{a}
 }
 
 // Return a new list that copies all elements.
@@ -216,6 +227,7 @@ func (a *List[T]) linkFirst(element T) *LinkedListNode[T] {
 		first.prev = newNode
 	}
 	a.length++
+	a.modCount++
 	return newNode
 }
 
@@ -229,6 +241,7 @@ func (a *List[T]) linkLast(element T) *LinkedListNode[T] {
 		last.next = newNode
 	}
 	a.length++
+	a.modCount++
 	return newNode
 }
 
@@ -242,6 +255,7 @@ func (a *List[T]) linkBefore(element T, succ *LinkedListNode[T]) *LinkedListNode
 		pred.next = newNode
 	}
 	a.length++
+	a.modCount++
 	return newNode
 }
 
@@ -255,6 +269,7 @@ func (a *List[T]) linkAfter(element T, pred *LinkedListNode[T]) *LinkedListNode[
 		succ.prev = newNode
 	}
 	a.length++
+	a.modCount++
 	return newNode
 }
 
@@ -278,6 +293,7 @@ func (a *List[T]) unlink(x *LinkedListNode[T]) T {
 	var empty T
 	x.Value = empty
 	a.length--
+	a.modCount++
 	return element
 }
 
@@ -294,6 +310,7 @@ func (a *List[T]) unlinkFirst(x *LinkedListNode[T]) T {
 		next.prev = nil
 	}
 	a.length--
+	a.modCount++
 	return element
 }
 
@@ -310,6 +327,7 @@ func (a *List[T]) unlinkLast(x *LinkedListNode[T]) T {
 		prev.next = nil
 	}
 	a.length--
+	a.modCount++
 	return element
 }
 
@@ -341,6 +359,54 @@ func (a *List[T]) InsertFront(newElement T) *LinkedListNode[T] {
 	return a.linkLast(newElement)
 }
 
+// Move the node to the front of list.
+func (a *List[T]) MoveToFront(mark *LinkedListNode[T]) {
+	a.modCount++
+	if a.first == mark {
+		return
+	}
+	a.detach(mark)
+	mark.next = a.first
+	if a.first == nil {
+		a.last = mark
+	} else {
+		a.first.prev = mark
+	}
+	a.first = mark
+}
+
+// Move the node to the back of list.
+func (a *List[T]) MoveToBack(mark *LinkedListNode[T]) {
+	a.modCount++
+	if a.last == mark {
+		return
+	}
+	a.detach(mark)
+	mark.prev = a.last
+	if a.last == nil {
+		a.first = mark
+	} else {
+		a.last.next = mark
+	}
+	a.last = mark
+}
+
+// Unlink the node without releasing it, the length is not changed.
+func (a *List[T]) detach(x *LinkedListNode[T]) {
+	if x.prev == nil {
+		a.first = x.next
+	} else {
+		x.prev.next = x.next
+	}
+	if x.next == nil {
+		a.last = x.prev
+	} else {
+		x.next.prev = x.prev
+	}
+	x.prev = nil
+	x.next = nil
+}
+
 type LinkedListNode[T any] struct {
 	Value T
 	next  *LinkedListNode[T]
@@ -356,10 +422,17 @@ func (a *LinkedListNode[T]) Prev() *LinkedListNode[T] {
 }
 
 type linkedListIterator[T any] struct {
-	current *LinkedListNode[T]
+	current      *LinkedListNode[T]
+	lastReturned *LinkedListNode[T]
+	source       *List[T]
+	modCount     int
 }
 
 func (a *linkedListIterator[T]) Next() option.Option[T] {
+	if a.modCount != a.source.modCount {
+		panic(seq.ConcurrentModification)
+	}
+	a.lastReturned = a.current
 	if a.current != nil {
 		var current = a.current.Value
 		a.current = a.current.next
@@ -368,6 +441,44 @@ func (a *linkedListIterator[T]) Next() option.Option[T] {
 	return option.None[T]()
 }
 
+func (a *linkedListIterator[T]) Remove() {
+	if a.lastReturned == nil {
+		panic(seq.IllegalState)
+	}
+	if a.modCount != a.source.modCount {
+		panic(seq.ConcurrentModification)
+	}
+	a.source.unlink(a.lastReturned)
+	a.lastReturned = nil
+	a.modCount = a.source.modCount
+}
+
+type linkedListReversed[T any] struct {
+	source *List[T]
+}
+
+func (a linkedListReversed[T]) Iterator() seq.Iterator[T] {
+	return &linkedListReversedIterator[T]{a.source.last, a.source, a.source.modCount}
+}
+
+type linkedListReversedIterator[T any] struct {
+	current  *LinkedListNode[T]
+	source   *List[T]
+	modCount int
+}
+
+func (a *linkedListReversedIterator[T]) Next() option.Option[T] {
+	if a.modCount != a.source.modCount {
+		panic(seq.ConcurrentModification)
+	}
+	if a.current != nil {
+		var current = a.current.Value
+		a.current = a.current.prev
+		return option.Some(current)
+	}
+	return option.None[T]()
+}
+
 func LinkedListCollector[T any]() seq.Collector[*List[T], T, *List[T]] {
 	return linkedListCollector[T]{}
 }
@@ -2,6 +2,9 @@ package list
 
 import (
 	"testing"
+
+	"github.com/kulics/gollection/internal/panics"
+	"github.com/kulics/gollection/seq"
 )
 
 func TestLinkedList(t *testing.T) {
@@ -76,4 +79,61 @@ func TestLinkedList(t *testing.T) {
 	if list.Count() != 6 {
 		t.Fatal("list count not eq 6")
 	}
+	var node = list.Front().Next()
+	list.MoveToFront(node)
+	if list.First().Get() != 2 || list.Count() != 6 {
+		t.Fatal("move to front error")
+	}
+	list.MoveToBack(list.Front())
+	if list.Last().Get() != 2 || list.First().Get() != 1 {
+		t.Fatal("move to back error")
+	}
+	var expect = []int{1, 3, 1, 2, 3, 2}
+	var it2 = list.Iterator()
+	for _, v := range expect {
+		if it2.Next().OrPanic() != v {
+			t.Fatal("move order error")
+		}
+	}
+	if list.Back().Prev().Value != 3 {
+		t.Fatal("move prev link error")
+	}
+	list = Of(1, 2, 3, 4)
+	var iter = list.Iterator().(seq.MutableIterator[int])
+	for {
+		if v, ok := iter.Next().Val(); ok {
+			if v%2 == 1 {
+				iter.Remove()
+			}
+		} else {
+			break
+		}
+	}
+	if list.Count() != 2 || list.First().Get() != 2 || list.Last().Get() != 4 {
+		t.Fatal("iterator remove error")
+	}
+	if panics.Recover(iter.Remove) != seq.IllegalState {
+		t.Fatal("remove after end error")
+	}
+	iter = list.Iterator().(seq.MutableIterator[int])
+	list.MoveToBack(list.Front())
+	if panics.Recover(func() { iter.Next() }) != seq.ConcurrentModification {
+		t.Fatal("fail fast error")
+	}
+}
+
+func TestLinkedListBulk(t *testing.T) {
+	var list = Of(1, 2, 3, 4, 5, 6)
+	if list.RemoveIf(func(i int) bool { return i%2 == 1 }) != 3 || list.Count() != 3 || list.First().Get() != 2 || list.Last().Get() != 6 {
+		t.Fatal("remove if error")
+	}
+	if list.ReplaceAll(func(i int) int { return i + 1 }) != 3 || list.Front().Next().Value != 5 {
+		t.Fatal("replace all error")
+	}
+	if RemoveAll[int](list, Of(3, 7)) != 2 || list.Count() != 1 || list.First().Get() != 5 || list.Front().Prev() != nil {
+		t.Fatal("remove all error")
+	}
+	if RetainAll[int](list, Of(1)) != 1 || list.Count() != 0 || list.Front() != nil || list.Back() != nil {
+		t.Fatal("retain all error")
+	}
 }
@@ -0,0 +1,39 @@
+//go:build go1.23
+
+package list
+
+import (
+	"testing"
+
+	"github.com/kulics/gollection/internal/panics"
+	"github.com/kulics/gollection/seq"
+)
+
+func TestLinkedListRange(t *testing.T) {
+	var list = Of(1, 2, 3)
+	var sum = 0
+	for i, v := range list.All() {
+		sum += i * v
+	}
+	if sum != 8 {
+		t.Fatal("linked list all error")
+	}
+	var remove = func() {
+		for v := range list.Values() {
+			if v == 2 {
+				list.RemoveFirst()
+			}
+		}
+	}
+	if panics.Recover(remove) != seq.ConcurrentModification {
+		t.Fatal("linked list range fail fast error")
+	}
+	var reversed = list.Reversed().Iterator()
+	if reversed.Next().OrPanic() != 3 {
+		t.Fatal("linked list reversed error")
+	}
+	list.AddLast(4)
+	if panics.Recover(func() { reversed.Next() }) != seq.ConcurrentModification {
+		t.Fatal("linked list reversed fail fast error")
+	}
+}
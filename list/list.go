@@ -30,19 +30,22 @@ func Make[T any](capacity int) *List[T] {
 	if capacity < defaultElementsLength {
 		capacity = defaultElementsLength
 	}
-	return &List[T]{make([]T, capacity), 0}
+	return &List[T]{elements: make([]T, capacity)}
 }
 
 // Constructing an List from other Collection.
 func From[T any](collection seq.Collection[T]) *List[T] {
-	return &List[T]{seq.ToSlice(collection), collection.Count()}
+	return &List[T]{elements: seq.ToSlice(collection), length: collection.Count()}
 }
 
 // List implemented using Array.
 // It has easier in-place modification than the built-in slice.
+// The iterators panic with seq.ConcurrentModification when the length of list is changed during iteration,
+// except by the Remove of the iterator itself.
 type List[T any] struct {
 	elements []T
 	length   int
+	modCount int
 }
 
 // Returns the index at the end.
@@ -61,6 +64,7 @@ func (a *List[T]) AddLast(element T) {
 	if growLength := a.length + 1; len(a.elements) < growLength {
 		a.grow(growLength)
 	}
+	a.modCount++
 	a.elements[a.length] = element
 	a.length++
 }
@@ -72,6 +76,7 @@ func (a *List[T]) RemoveLast() option.Option[T] {
 	}
 	var removed = a.elements[a.length-1]
 	var emptyValue T
+	a.modCount++
 	a.elements[a.length-1] = emptyValue
 	a.length--
 	return option.Some(removed)
@@ -100,8 +105,10 @@ func (a *List[T]) Add(index int, element T) {
 	if growLength := a.length + 1; len(a.elements) < growLength {
 		a.grow(growLength)
 	}
-	copy(a.elements[index+1:], a.elements[index:])
+	copy(a.elements[index+1:], a.elements[index:a.length])
+	a.modCount++
 	a.elements[index] = element
+	a.length++
 }
 
 // Add multiple elements at the index.
@@ -113,6 +120,7 @@ func (a *List[T]) AddAll(index int, elements seq.Collection[T]) {
 	if growLength := a.length + additional; len(a.elements) < growLength {
 		a.grow(growLength)
 	}
+	a.modCount++
 	copy(a.elements[index+additional:], a.elements[index:])
 	var i = index
 	seq.ForEach[T](func(item T) {
@@ -128,8 +136,9 @@ func (a *List[T]) Remove(index int) T {
 		panic(seq.OutOfBounds)
 	}
 	var removed = a.elements[index]
-	copy(a.elements[:index], a.elements[index+1:])
+	copy(a.elements[index:], a.elements[index+1:a.length])
 	var emptyValue T
+	a.modCount++
 	a.elements[a.length-1] = emptyValue
 	a.length--
 	return removed
@@ -143,6 +152,7 @@ func (a *List[T]) RemoveRange(begin, end int) {
 	if end == begin {
 		return
 	}
+	a.modCount++
 	copy(a.elements[begin:], a.elements[end:])
 	var emptyValue T
 	for i := len(a.elements) - (end - begin); i < len(a.elements); i++ {
@@ -165,6 +175,7 @@ func (a *List[T]) Clear() {
 		a.elements[i] = emptyValue
 	}
 	a.length = 0
+	a.modCount++
 }
 
 // Return the number of elements of list.
@@ -172,9 +183,9 @@ func (a *List[T]) Count() int {
 	return a.length
 }
 
-// Return the Iterator of list.
+// Return the Iterator of list, it implements seq.MutableIterator.
 func (a *List[T]) Iterator() seq.Iterator[T] {
-	return &arrayListIterator[T]{-1, a}
+	return &arrayListIterator[T]{index: -1, source: a, modCount: a.modCount}
 }
 
 // Return a new list that copies all elements.
@@ -210,18 +221,38 @@ func (a *List[T]) grow(minCapacity int) {
 }
 
 type arrayListIterator[T any] struct {
-	index  int
-	source *List[T]
+	index     int
+	source    *List[T]
+	modCount  int
+	removable bool
 }
 
 func (a *arrayListIterator[T]) Next() option.Option[T] {
+	if a.modCount != a.source.modCount {
+		panic(seq.ConcurrentModification)
+	}
 	if a.index < a.source.Count()-1 {
 		a.index++
+		a.removable = true
 		return option.Some(a.source.elements[a.index])
 	}
+	a.removable = false
 	return option.None[T]()
 }
 
+func (a *arrayListIterator[T]) Remove() {
+	if !a.removable {
+		panic(seq.IllegalState)
+	}
+	if a.modCount != a.source.modCount {
+		panic(seq.ConcurrentModification)
+	}
+	a.source.Remove(a.index)
+	a.index--
+	a.modCount = a.source.modCount
+	a.removable = false
+}
+
 func Collector[T any]() seq.Collector[*List[T], T, *List[T]] {
 	return collector[T]{}
 }
@@ -3,6 +3,7 @@ package list
 import (
 	"testing"
 
+	"github.com/kulics/gollection/internal/panics"
 	"github.com/kulics/gollection/seq"
 )
 
@@ -104,4 +105,181 @@ func TestArrayList(t *testing.T) {
 	if !seq.Equals[int](Of(1, 3), list) {
 		t.Fatal("list elements not expect")
 	}
+	list = Of(1, 2, 3, 4, 5)
+	var iter = list.Iterator().(seq.MutableIterator[int])
+	if panics.Recover(iter.Remove) != seq.IllegalState {
+		t.Fatal("remove before next error")
+	}
+	for {
+		if v, ok := iter.Next().Val(); ok {
+			if v%2 == 0 {
+				iter.Remove()
+			}
+		} else {
+			break
+		}
+	}
+	if !seq.Equals[int](Of(1, 3, 5), list) {
+		t.Fatal("iterator remove error")
+	}
+	iter = list.Iterator().(seq.MutableIterator[int])
+	iter.Next()
+	list.AddLast(6)
+	if panics.Recover(func() { iter.Next() }) != seq.ConcurrentModification {
+		t.Fatal("fail fast error")
+	}
+	var view = list.Slice(0, 2)
+	list.RemoveLast()
+	if panics.Recover(func() { view.At(0) }) != seq.ConcurrentModification {
+		t.Fatal("slice fail fast error")
+	}
+	iter = list.Iterator().(seq.MutableIterator[int])
+	list.Set(0, 10)
+	if iter.Next().OrPanic() != 10 {
+		t.Fatal("set during iteration error")
+	}
+}
+
+func TestArrayListAdd(t *testing.T) {
+	var list = Of(1, 3)
+	list.Add(1, 2)
+	list.Add(0, 0)
+	list.Add(4, 4)
+	if list.Count() != 5 {
+		t.Fatal("list add count error")
+	}
+	for i := 0; i < 5; i++ {
+		if list.At(i).Get() != i {
+			t.Fatal("list add error")
+		}
+	}
+}
+
+func TestArrayListRemove(t *testing.T) {
+	var list = Of(0, 1, 2, 3)
+	if list.Remove(1) != 1 || list.Remove(2) != 3 || list.Count() != 2 {
+		t.Fatal("list remove error")
+	}
+	if list.At(0).Get() != 0 || list.At(1).Get() != 2 {
+		t.Fatal("list remove order error")
+	}
+}
+
+func TestListSort(t *testing.T) {
+	var list = Of(5, 3, 9, 1, 7)
+	list.AddLast(4)
+	Sort(list)
+	if !IsSorted(list) || !seq.Equals[int](Of(1, 3, 4, 5, 7, 9), list) || list.Capacity() != 10 {
+		t.Fatal("sort error")
+	}
+	if i, ok := BinarySearch(list, 7); !ok || i != 4 {
+		t.Fatal("binary search error")
+	}
+	if i, ok := BinarySearch(list, 6); ok || i != 4 {
+		t.Fatal("binary search missing error")
+	}
+	var pairs = Of(
+		seq.Pair[int, string]{First: 2, Second: "a"},
+		seq.Pair[int, string]{First: 1, Second: "b"},
+		seq.Pair[int, string]{First: 2, Second: "c"},
+		seq.Pair[int, string]{First: 1, Second: "d"},
+	)
+	pairs.SortStableFunc(func(l, r seq.Pair[int, string]) bool { return l.First < r.First })
+	for i, v := range []string{"b", "d", "a", "c"} {
+		if pairs.At(i).Get().Second != v {
+			t.Fatal("sort stable error")
+		}
+	}
+	var descending = func(l, r int) bool { return l > r }
+	list.SortFunc(descending)
+	if !list.IsSortedFunc(descending) || list.First().Get() != 9 {
+		t.Fatal("sort func error")
+	}
+	if i, ok := list.BinarySearchFunc(3, func(e, target int) int { return target - e }); !ok || i != 4 {
+		t.Fatal("binary search func error")
+	}
+}
+
+func TestListSlice(t *testing.T) {
+	var list = Of(0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+	var view = list.Slice(2, 8)
+	if view.Count() != 6 || view.At(0).Get() != 2 || view.At(6).IsNotNil() {
+		t.Fatal("slice error")
+	}
+	var sum = 0
+	seq.ForEach[int](func(i int) {
+		sum += i
+	}, view)
+	if sum != 2+3+4+5+6+7 || !seq.Equals[int](Of(2, 3, 4, 5, 6, 7), view) {
+		t.Fatal("slice iterator error")
+	}
+	if view.Set(0, 20) != 2 || list.At(2).Get() != 20 {
+		t.Fatal("slice set error")
+	}
+	list.Set(3, 30)
+	if view.At(1).Get() != 30 {
+		t.Fatal("slice shared error")
+	}
+	view.Reverse()
+	if !seq.Equals[int](Of(0, 1, 7, 6, 5, 4, 30, 20, 8, 9), list) {
+		t.Fatal("slice reverse error")
+	}
+	view.Rotate(2)
+	if !seq.Equals[int](Of(0, 1, 5, 4, 30, 20, 7, 6, 8, 9), list) {
+		t.Fatal("slice rotate error")
+	}
+	view.Rotate(-2)
+	view.Swap(0, 5)
+	if !seq.Equals[int](Of(0, 1, 20, 6, 5, 4, 30, 7, 8, 9), list) {
+		t.Fatal("slice swap error")
+	}
+	var inner = view.Slice(1, 3)
+	inner.Fill(-1)
+	if !seq.Equals[int](Of(0, 1, 20, -1, -1, 4, 30, 7, 8, 9), list) {
+		t.Fatal("slice fill error")
+	}
+	var target = make([]int, 4)
+	if view.CopyTo(target) != 4 || target[0] != 20 || target[3] != 4 {
+		t.Fatal("slice copy error")
+	}
+	list.Reverse()
+	list.Rotate(-1)
+	list.Swap(0, 9)
+	if list.CopyTo(target) != 4 || target[0] != 1 || target[1] != 9 || target[3] != 7 {
+		t.Fatal("list bulk error")
+	}
+	list.Fill(1)
+	if seq.Sum[int](list) != 10 {
+		t.Fatal("list fill error")
+	}
+	list.RemoveRange(0, 9)
+	defer func() {
+		if recover() == nil {
+			t.Fatal("slice out of bounds error")
+		}
+	}()
+	view.At(0)
+}
+
+func TestListBulk(t *testing.T) {
+	var list = Of(1, 2, 3, 4, 5, 6, 7, 8)
+	list.Add(1, 10)
+	if list.Remove(0) != 1 || !seq.Equals[int](Of(10, 2, 3, 4, 5, 6, 7, 8), list) {
+		t.Fatal("add and remove error")
+	}
+	if list.RemoveIf(func(i int) bool { return i%2 == 0 }) != 5 || !seq.Equals[int](Of(3, 5, 7), list) {
+		t.Fatal("remove if error")
+	}
+	if list.elements[3] != 0 {
+		t.Fatal("remove if clear error")
+	}
+	if list.ReplaceAll(func(i int) int { return i * 10 }) != 3 || !seq.Equals[int](Of(30, 50, 70), list) {
+		t.Fatal("replace all error")
+	}
+	if RemoveAll[int](list, Of(50, 60)) != 1 || !seq.Equals[int](Of(30, 70), list) {
+		t.Fatal("remove all error")
+	}
+	if RetainAll[int](list, Of(70, 80)) != 1 || !seq.Equals[int](Of(70), list) {
+		t.Fatal("retain all error")
+	}
 }
@@ -0,0 +1,69 @@
+//go:build go1.23
+
+package list
+
+import (
+	"iter"
+
+	"github.com/kulics/gollection/seq"
+)
+
+// Return an iter.Seq2 of the indexes and elements of list, which can be used in range-over-func loops.
+// Like Iterator, it panics with seq.ConcurrentModification when list is modified during the loop.
+func (a *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		var modCount = a.modCount
+		for i := 0; i < a.length; i++ {
+			a.checkModCount(modCount)
+			if !yield(i, a.elements[i]) {
+				return
+			}
+		}
+		a.checkModCount(modCount)
+	}
+}
+
+// Return an iter.Seq of the indexes of list.
+func (a *List[T]) Keys() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		var modCount = a.modCount
+		for i := 0; i < a.length; i++ {
+			a.checkModCount(modCount)
+			if !yield(i) {
+				return
+			}
+		}
+		a.checkModCount(modCount)
+	}
+}
+
+// Return an iter.Seq of the elements of list.
+func (a *List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var modCount = a.modCount
+		for i := 0; i < a.length; i++ {
+			a.checkModCount(modCount)
+			if !yield(a.elements[i]) {
+				return
+			}
+		}
+		a.checkModCount(modCount)
+	}
+}
+
+// Return an iter.Seq2 of the indexes and elements of view, the indexes start from 0.
+func (a *SubList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < len(a.elements()); i++ {
+			if !yield(i, a.elements()[i]) {
+				return
+			}
+		}
+	}
+}
+
+func (a *List[T]) checkModCount(modCount int) {
+	if a.modCount != modCount {
+		panic(seq.ConcurrentModification)
+	}
+}
@@ -0,0 +1,52 @@
+//go:build go1.23
+
+package list
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kulics/gollection/internal/panics"
+	"github.com/kulics/gollection/seq"
+)
+
+func TestArrayListRange(t *testing.T) {
+	var list = Of(1, 2, 3)
+	for i, v := range list.All() {
+		if list.At(i).Get() != v {
+			t.Fatal("list all error")
+		}
+	}
+	if !slices.Equal(slices.Collect(list.Values()), []int{1, 2, 3}) {
+		t.Fatal("list values error")
+	}
+	if !slices.Equal(slices.Collect(list.Keys()), []int{0, 1, 2}) {
+		t.Fatal("list keys error")
+	}
+	list = Of(1, 2, 3)
+	var add = func() {
+		for _, v := range list.All() {
+			list.AddLast(v)
+		}
+	}
+	if panics.Recover(add) != seq.ConcurrentModification {
+		t.Fatal("list range fail fast error")
+	}
+	var view = list.Slice(0, 2)
+	var remove = func() {
+		for range view.All() {
+			list.Remove(0)
+		}
+	}
+	if panics.Recover(remove) != seq.ConcurrentModification {
+		t.Fatal("sub list range fail fast error")
+	}
+	var sum = 0
+	for v := range list.Values() {
+		sum += v
+		list.Set(0, v)
+	}
+	if sum != 6 {
+		t.Fatal("list range set error")
+	}
+}
@@ -0,0 +1,182 @@
+package list
+
+import (
+	"github.com/kulics/gollection/option"
+	"github.com/kulics/gollection/ref"
+	"github.com/kulics/gollection/seq"
+)
+
+// Return a view of the elements between begin and end of list.
+// The view shares the elements with list, so the changes through either are visible in the other.
+// The view panics with seq.ConcurrentModification when it is used after the length of list is changed.
+func (a *List[T]) Slice(begin, end int) *SubList[T] {
+	if begin < 0 || end > a.length || begin > end {
+		panic(seq.OutOfBounds)
+	}
+	return &SubList[T]{a, begin, end, a.modCount}
+}
+
+// Replace the element at the index, return the old element.
+func (a *List[T]) Set(index int, element T) T {
+	if a.isOutOfBounds(index) {
+		panic(seq.OutOfBounds)
+	}
+	var old = a.elements[index]
+	a.elements[index] = element
+	return old
+}
+
+// Replace all elements with value.
+func (a *List[T]) Fill(value T) {
+	fill(a.elements[:a.length], value)
+}
+
+// Reverse the order of elements.
+func (a *List[T]) Reverse() {
+	reverse(a.elements[:a.length])
+}
+
+// Rotate the elements to the left by count, the element at count becomes the first.
+// A negative count rotates the elements to the right.
+func (a *List[T]) Rotate(count int) {
+	rotate(a.elements[:a.length], count)
+}
+
+// Swap the elements at i and j.
+func (a *List[T]) Swap(i, j int) {
+	if a.isOutOfBounds(i) || a.isOutOfBounds(j) {
+		panic(seq.OutOfBounds)
+	}
+	a.elements[i], a.elements[j] = a.elements[j], a.elements[i]
+}
+
+// Copy the elements to target, return the number of elements copied,
+// which is the minimum of the count of list and the length of target.
+func (a *List[T]) CopyTo(target []T) int {
+	return copy(target, a.elements[:a.length])
+}
+
+// SubList is a view of a range of List.
+type SubList[T any] struct {
+	source   *List[T]
+	begin    int
+	end      int
+	modCount int
+}
+
+// Return the elements of view, panic when the length of list is changed after the view is created.
+func (a *SubList[T]) elements() []T {
+	if a.modCount != a.source.modCount {
+		panic(seq.ConcurrentModification)
+	}
+	return a.source.elements[a.begin:a.end]
+}
+
+// Return the number of elements of view.
+func (a *SubList[T]) Count() int {
+	return a.end - a.begin
+}
+
+// Return the element at the index of view.
+// Return None when a subscript is out of bounds.
+func (a *SubList[T]) At(index int) ref.Ref[T] {
+	var elements = a.elements()
+	if index < 0 || index >= len(elements) {
+		return ref.Of[T](nil)
+	}
+	return ref.Of(&elements[index])
+}
+
+// Replace the element at the index of view, return the old element.
+func (a *SubList[T]) Set(index int, element T) T {
+	var elements = a.elements()
+	if index < 0 || index >= len(elements) {
+		panic(seq.OutOfBounds)
+	}
+	var old = elements[index]
+	elements[index] = element
+	return old
+}
+
+// Return a view of the elements between begin and end of view.
+func (a *SubList[T]) Slice(begin, end int) *SubList[T] {
+	if begin < 0 || end > a.Count() || begin > end {
+		panic(seq.OutOfBounds)
+	}
+	return &SubList[T]{a.source, a.begin + begin, a.begin + end, a.modCount}
+}
+
+// Replace all elements of view with value.
+func (a *SubList[T]) Fill(value T) {
+	fill(a.elements(), value)
+}
+
+// Reverse the order of elements of view.
+func (a *SubList[T]) Reverse() {
+	reverse(a.elements())
+}
+
+// Rotate the elements of view to the left by count, the element at count becomes the first.
+// A negative count rotates the elements to the right.
+func (a *SubList[T]) Rotate(count int) {
+	rotate(a.elements(), count)
+}
+
+// Swap the elements at i and j of view.
+func (a *SubList[T]) Swap(i, j int) {
+	var elements = a.elements()
+	if i < 0 || i >= len(elements) || j < 0 || j >= len(elements) {
+		panic(seq.OutOfBounds)
+	}
+	elements[i], elements[j] = elements[j], elements[i]
+}
+
+// Copy the elements of view to target, return the number of elements copied,
+// which is the minimum of the count of view and the length of target.
+func (a *SubList[T]) CopyTo(target []T) int {
+	return copy(target, a.elements())
+}
+
+// Return the Iterator of view.
+func (a *SubList[T]) Iterator() seq.Iterator[T] {
+	return &subListIterator[T]{a.begin - 1, a}
+}
+
+type subListIterator[T any] struct {
+	index  int
+	source *SubList[T]
+}
+
+func (a *subListIterator[T]) Next() option.Option[T] {
+	if a.index < a.source.end-1 {
+		a.index++
+		return option.Some(a.source.elements()[a.index-a.source.begin])
+	}
+	return option.None[T]()
+}
+
+func fill[T any](elements []T, value T) {
+	for i := range elements {
+		elements[i] = value
+	}
+}
+
+func reverse[T any](elements []T) {
+	for i, j := 0, len(elements)-1; i < j; i, j = i+1, j-1 {
+		elements[i], elements[j] = elements[j], elements[i]
+	}
+}
+
+func rotate[T any](elements []T, count int) {
+	var length = len(elements)
+	if length == 0 {
+		return
+	}
+	count %= length
+	if count < 0 {
+		count += length
+	}
+	reverse(elements[:count])
+	reverse(elements[count:])
+	reverse(elements)
+}
@@ -0,0 +1,45 @@
+package list
+
+import (
+	"golang.org/x/exp/constraints"
+	"golang.org/x/exp/slices"
+)
+
+// Sort the elements of list in ascending order.
+func Sort[T constraints.Ordered](list *List[T]) {
+	slices.Sort(list.elements[:list.length])
+}
+
+// Returns true if the elements of list are in ascending order.
+func IsSorted[T constraints.Ordered](list *List[T]) bool {
+	return slices.IsSorted(list.elements[:list.length])
+}
+
+// Search the target in the sorted list, return the index of the target and true if it is found,
+// otherwise return the index where the target would be added and false.
+func BinarySearch[T constraints.Ordered](list *List[T], target T) (int, bool) {
+	return slices.BinarySearch(list.elements[:list.length], target)
+}
+
+// Sort the elements of list by less.
+func (a *List[T]) SortFunc(less func(T, T) bool) {
+	slices.SortFunc(a.elements[:a.length], less)
+}
+
+// Sort the elements of list by less, the order of equal elements is kept.
+func (a *List[T]) SortStableFunc(less func(T, T) bool) {
+	slices.SortStableFunc(a.elements[:a.length], less)
+}
+
+// Returns true if the elements of list are sorted by less.
+func (a *List[T]) IsSortedFunc(less func(T, T) bool) bool {
+	return slices.IsSortedFunc(a.elements[:a.length], less)
+}
+
+// Search the target in the list sorted by cmp, return the index of the target and true if it is found,
+// otherwise return the index where the target would be added and false.
+// The cmp returns a negative number when the element is before the target,
+// zero when it is equal to the target, and a positive number when it is after the target.
+func (a *List[T]) BinarySearchFunc(target T, cmp func(T, T) int) (int, bool) {
+	return slices.BinarySearchFunc(a.elements[:a.length], target, cmp)
+}
@@ -0,0 +1,541 @@
+package persistent
+
+import (
+	mathbits "math/bits"
+
+	"github.com/kulics/gollection/dict"
+	"github.com/kulics/gollection/option"
+	"github.com/kulics/gollection/seq"
+)
+
+// Constructing a Map with variable-length parameters.
+func MapOf[K comparable, V any](elements ...dict.Entry[K, V]) Map[K, V] {
+	return MapFrom[K, V](seq.Slice[dict.Entry[K, V]](elements))
+}
+
+// Constructing an empty Map.
+func MakeMap[K comparable, V any]() Map[K, V] {
+	return MakeMapWithHasher[K, V](dict.DefaultHasher[K]())
+}
+
+// Constructing an empty Map with hasher.
+func MakeMapWithHasher[K comparable, V any](hasher func(K) uint64) Map[K, V] {
+	return Map[K, V]{hasher: &mapHasher[K]{hasher}}
+}
+
+// Constructing a Map from other Sequence.
+func MapFrom[K comparable, V any](it seq.Sequence[dict.Entry[K, V]]) Map[K, V] {
+	var builder = MakeMap[K, V]().Transient()
+	seq.ForEach(func(t dict.Entry[K, V]) {
+		builder.Add(t.Key, t.Value)
+	}, it)
+	return builder.Persistent()
+}
+
+// Map is an immutable dict implemented using hash array mapped trie in the compressed (CHAMP) layout.
+// The operations return a new Map sharing most of the structure with the old one.
+// Maps derived from the same Map share the hasher, so they have the same layout for the same entries,
+// which makes comparing two versions proportional to their difference.
+// The zero value is an empty Map with the default hasher.
+type Map[K comparable, V any] struct {
+	root   *mapNode[K, V]
+	length int
+	hasher *mapHasher[K]
+}
+
+type mapHasher[K comparable] struct {
+	hash func(K) uint64
+}
+
+type mapEntry[K comparable, V any] struct {
+	hash  uint64
+	key   K
+	value V
+}
+
+// The node stores the entries at the positions of dataMap and the children at the positions of nodeMap.
+// The node below the last level of hash stores the entries with the same hash, in which case collision is true.
+type mapNode[K comparable, V any] struct {
+	owner     *owner
+	dataMap   uint32
+	nodeMap   uint32
+	entries   []mapEntry[K, V]
+	children  []*mapNode[K, V]
+	collision bool
+}
+
+// Change is a difference between two Maps, Old is None when the key is added,
+// and New is None when the key is removed.
+type Change[K comparable, V any] struct {
+	Key K
+	Old option.Option[V]
+	New option.Option[V]
+}
+
+// Return the number of entries of map.
+func (a Map[K, V]) Count() int {
+	return a.length
+}
+
+// Returns true if the key is included in the map.
+func (a Map[K, V]) Contains(key K) bool {
+	return a.At(key).IsSome()
+}
+
+// Return the value of the key.
+// Return None when the key is not included.
+func (a Map[K, V]) At(key K) option.Option[V] {
+	if a.root == nil {
+		return option.None[V]()
+	}
+	return a.root.at(key, a.hasher.hash(key), 0)
+}
+
+// Return a new map with the value of the key added.
+func (a Map[K, V]) Add(key K, value V) Map[K, V] {
+	var root, hasher = a.root, a.hasherOf()
+	if root == nil {
+		root = &mapNode[K, V]{}
+	}
+	var newRoot, added = root.add(nil, mapEntry[K, V]{hasher.hash(key), key, value}, 0)
+	var length = a.length
+	if added {
+		length++
+	}
+	return Map[K, V]{newRoot, length, hasher}
+}
+
+// Return a new map with the key removed.
+// Return the map itself when the key is not included.
+func (a Map[K, V]) Remove(key K) Map[K, V] {
+	if a.root == nil {
+		return a
+	}
+	var newRoot, removed = a.root.remove(nil, key, a.hasher.hash(key), 0)
+	if !removed {
+		return a
+	}
+	if a.length == 1 {
+		// The empty map always has a nil root, so that it is equal to the other empty maps.
+		newRoot = nil
+	}
+	return Map[K, V]{newRoot, a.length - 1, a.hasher}
+}
+
+// Return the Iterator of map.
+func (a Map[K, V]) Iterator() seq.Iterator[dict.Entry[K, V]] {
+	var iterator = &mapIterator[K, V]{}
+	if a.root != nil {
+		iterator.push(a.root)
+	}
+	return iterator
+}
+
+// Return a MapTransient that starts with the entries of map, for modifying in batches.
+func (a Map[K, V]) Transient() *MapTransient[K, V] {
+	var root = a.root
+	if root == nil {
+		root = &mapNode[K, V]{}
+	}
+	return &MapTransient[K, V]{&owner{}, root, a.length, a.hasherOf()}
+}
+
+// Return the hasher of map, the zero value uses the default hasher.
+func (a Map[K, V]) hasherOf() *mapHasher[K] {
+	if a.hasher == nil {
+		return &mapHasher[K]{dict.DefaultHasher[K]()}
+	}
+	return a.hasher
+}
+
+// Returns true if the maps contain the same entries.
+// It skips the shared structure when the maps are derived from the same Map.
+func MapEquals[K comparable, V comparable](l Map[K, V], r Map[K, V]) bool {
+	if l.length != r.length {
+		return false
+	}
+	if l.length == 0 || l.root == r.root {
+		return true
+	}
+	if l.hasher == r.hasher {
+		return nodeEquals(l.root, r.root)
+	}
+	return seq.AllMatch[dict.Entry[K, V]](func(e dict.Entry[K, V]) bool {
+		var v, ok = r.At(e.Key).Val()
+		return ok && v == e.Value
+	}, l)
+}
+
+// Return the changes from the old map to the new map.
+// It skips the shared structure when the maps are derived from the same Map.
+func MapDiff[K comparable, V comparable](old Map[K, V], new Map[K, V]) seq.Slice[Change[K, V]] {
+	var changes = make([]Change[K, V], 0)
+	if old.root == new.root {
+		return changes
+	}
+	if old.hasher == new.hasher {
+		return nodeDiff(old.root, new.root, changes)
+	}
+	seq.ForEach[dict.Entry[K, V]](func(e dict.Entry[K, V]) {
+		if v, ok := new.At(e.Key).Val(); !ok {
+			changes = append(changes, Change[K, V]{e.Key, option.Some(e.Value), option.None[V]()})
+		} else if v != e.Value {
+			changes = append(changes, Change[K, V]{e.Key, option.Some(e.Value), option.Some(v)})
+		}
+	}, old)
+	seq.ForEach[dict.Entry[K, V]](func(e dict.Entry[K, V]) {
+		if !old.Contains(e.Key) {
+			changes = append(changes, Change[K, V]{e.Key, option.None[V](), option.Some(e.Value)})
+		}
+	}, new)
+	return changes
+}
+
+// MapTransient is a mutable builder of Map, it modifies the nodes it created in place.
+// It must not be used after Persistent is called.
+type MapTransient[K comparable, V any] struct {
+	edit   *owner
+	root   *mapNode[K, V]
+	length int
+	hasher *mapHasher[K]
+}
+
+// Return the number of entries of transient.
+func (a *MapTransient[K, V]) Count() int {
+	return a.length
+}
+
+// Return the value of the key.
+// Return None when the key is not included.
+func (a *MapTransient[K, V]) At(key K) option.Option[V] {
+	return a.root.at(key, a.hasher.hash(key), 0)
+}
+
+// Add the value of the key.
+func (a *MapTransient[K, V]) Add(key K, value V) {
+	var added bool
+	a.root, added = a.root.add(a.edit, mapEntry[K, V]{a.hasher.hash(key), key, value}, 0)
+	if added {
+		a.length++
+	}
+}
+
+// Remove the key.
+func (a *MapTransient[K, V]) Remove(key K) {
+	var removed bool
+	a.root, removed = a.root.remove(a.edit, key, a.hasher.hash(key), 0)
+	if removed {
+		a.length--
+	}
+}
+
+// Return a Map of the entries, the transient must not be used after that.
+func (a *MapTransient[K, V]) Persistent() Map[K, V] {
+	a.edit = nil
+	if a.length == 0 {
+		return Map[K, V]{nil, 0, a.hasher}
+	}
+	return Map[K, V]{a.root, a.length, a.hasher}
+}
+
+func bitOf(hash uint64, shift int) uint32 {
+	return 1 << ((hash >> shift) & mask)
+}
+
+func indexOf(bitmap uint32, bit uint32) int {
+	return mathbits.OnesCount32(bitmap & (bit - 1))
+}
+
+func (a *mapNode[K, V]) editable(edit *owner) *mapNode[K, V] {
+	if edit != nil && a.owner == edit {
+		return a
+	}
+	var newNode = &mapNode[K, V]{
+		owner:     edit,
+		dataMap:   a.dataMap,
+		nodeMap:   a.nodeMap,
+		entries:   make([]mapEntry[K, V], len(a.entries)),
+		children:  make([]*mapNode[K, V], len(a.children)),
+		collision: a.collision,
+	}
+	copy(newNode.entries, a.entries)
+	copy(newNode.children, a.children)
+	return newNode
+}
+
+func (a *mapNode[K, V]) at(key K, hash uint64, shift int) option.Option[V] {
+	if a.collision {
+		for _, v := range a.entries {
+			if v.key == key {
+				return option.Some(v.value)
+			}
+		}
+		return option.None[V]()
+	}
+	var bit = bitOf(hash, shift)
+	if a.dataMap&bit != 0 {
+		if item := a.entries[indexOf(a.dataMap, bit)]; item.hash == hash && item.key == key {
+			return option.Some(item.value)
+		}
+		return option.None[V]()
+	}
+	if a.nodeMap&bit != 0 {
+		return a.children[indexOf(a.nodeMap, bit)].at(key, hash, shift+bits)
+	}
+	return option.None[V]()
+}
+
+func (a *mapNode[K, V]) add(edit *owner, item mapEntry[K, V], shift int) (*mapNode[K, V], bool) {
+	if a.collision {
+		var newNode = a.editable(edit)
+		for i, v := range a.entries {
+			if v.key == item.key {
+				newNode.entries[i] = item
+				return newNode, false
+			}
+		}
+		newNode.entries = append(newNode.entries, item)
+		return newNode, true
+	}
+	var bit = bitOf(item.hash, shift)
+	if a.dataMap&bit != 0 {
+		var i = indexOf(a.dataMap, bit)
+		var current = a.entries[i]
+		var newNode = a.editable(edit)
+		if current.hash == item.hash && current.key == item.key {
+			newNode.entries[i] = item
+			return newNode, false
+		}
+		// Push both entries down to a new child.
+		var child = mergeEntries(edit, current, item, shift+bits)
+		newNode.entries = append(newNode.entries[:i], newNode.entries[i+1:]...)
+		newNode.dataMap ^= bit
+		newNode.nodeMap |= bit
+		var j = indexOf(newNode.nodeMap, bit)
+		newNode.children = append(newNode.children, nil)
+		copy(newNode.children[j+1:], newNode.children[j:])
+		newNode.children[j] = child
+		return newNode, true
+	}
+	if a.nodeMap&bit != 0 {
+		var j = indexOf(a.nodeMap, bit)
+		var child, added = a.children[j].add(edit, item, shift+bits)
+		var newNode = a.editable(edit)
+		newNode.children[j] = child
+		return newNode, added
+	}
+	var newNode = a.editable(edit)
+	var i = indexOf(a.dataMap, bit)
+	newNode.dataMap |= bit
+	newNode.entries = append(newNode.entries, mapEntry[K, V]{})
+	copy(newNode.entries[i+1:], newNode.entries[i:])
+	newNode.entries[i] = item
+	return newNode, true
+}
+
+func mergeEntries[K comparable, V any](edit *owner, a, b mapEntry[K, V], shift int) *mapNode[K, V] {
+	if shift >= 64 {
+		return &mapNode[K, V]{owner: edit, entries: []mapEntry[K, V]{a, b}, collision: true}
+	}
+	var bitA, bitB = bitOf(a.hash, shift), bitOf(b.hash, shift)
+	if bitA == bitB {
+		return &mapNode[K, V]{
+			owner:    edit,
+			nodeMap:  bitA,
+			children: []*mapNode[K, V]{mergeEntries(edit, a, b, shift+bits)},
+		}
+	}
+	if bitA > bitB {
+		a, b = b, a
+	}
+	return &mapNode[K, V]{owner: edit, dataMap: bitA | bitB, entries: []mapEntry[K, V]{a, b}}
+}
+
+// Returns true if the node can be inlined into its parent as an entry.
+func (a *mapNode[K, V]) isSingleEntry() bool {
+	return len(a.entries) == 1 && len(a.children) == 0
+}
+
+func (a *mapNode[K, V]) remove(edit *owner, key K, hash uint64, shift int) (*mapNode[K, V], bool) {
+	if a.collision {
+		for i, v := range a.entries {
+			if v.key == key {
+				var newNode = a.editable(edit)
+				newNode.entries = append(newNode.entries[:i], newNode.entries[i+1:]...)
+				return newNode, true
+			}
+		}
+		return a, false
+	}
+	var bit = bitOf(hash, shift)
+	if a.dataMap&bit != 0 {
+		var i = indexOf(a.dataMap, bit)
+		if item := a.entries[i]; item.hash != hash || item.key != key {
+			return a, false
+		}
+		var newNode = a.editable(edit)
+		newNode.dataMap ^= bit
+		newNode.entries = append(newNode.entries[:i], newNode.entries[i+1:]...)
+		return newNode, true
+	}
+	if a.nodeMap&bit != 0 {
+		var j = indexOf(a.nodeMap, bit)
+		var child, removed = a.children[j].remove(edit, key, hash, shift+bits)
+		if !removed {
+			return a, false
+		}
+		var newNode = a.editable(edit)
+		if child.isSingleEntry() {
+			// Inline the last entry of child to keep the layout canonical.
+			newNode.children = append(newNode.children[:j], newNode.children[j+1:]...)
+			newNode.nodeMap ^= bit
+			newNode.dataMap |= bit
+			var i = indexOf(newNode.dataMap, bit)
+			newNode.entries = append(newNode.entries, mapEntry[K, V]{})
+			copy(newNode.entries[i+1:], newNode.entries[i:])
+			newNode.entries[i] = child.entries[0]
+		} else {
+			newNode.children[j] = child
+		}
+		return newNode, true
+	}
+	return a, false
+}
+
+func nodeEquals[K comparable, V comparable](l, r *mapNode[K, V]) bool {
+	if l == r {
+		return true
+	}
+	if l == nil || r == nil {
+		return false
+	}
+	if l.collision {
+		if len(l.entries) != len(r.entries) {
+			return false
+		}
+		for _, v := range l.entries {
+			var found = false
+			for _, w := range r.entries {
+				if v.key == w.key {
+					found = v.value == w.value
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+	if l.dataMap != r.dataMap || l.nodeMap != r.nodeMap {
+		return false
+	}
+	for i, v := range l.entries {
+		if w := r.entries[i]; v.key != w.key || v.value != w.value {
+			return false
+		}
+	}
+	for i, v := range l.children {
+		if !nodeEquals(v, r.children[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func nodeDiff[K comparable, V comparable](old, new *mapNode[K, V], changes []Change[K, V]) []Change[K, V] {
+	if old == new {
+		return changes
+	}
+	if old == nil || new == nil || old.collision {
+		return entriesDiff(collectEntries(old, nil), collectEntries(new, nil), changes)
+	}
+	for position := 0; position < width; position++ {
+		var bit = uint32(1) << position
+		var oldEntries, newEntries []mapEntry[K, V]
+		if old.nodeMap&bit != 0 && new.nodeMap&bit != 0 {
+			changes = nodeDiff(old.children[indexOf(old.nodeMap, bit)], new.children[indexOf(new.nodeMap, bit)], changes)
+			continue
+		}
+		if old.dataMap&bit != 0 {
+			oldEntries = append(oldEntries, old.entries[indexOf(old.dataMap, bit)])
+		} else if old.nodeMap&bit != 0 {
+			oldEntries = collectEntries(old.children[indexOf(old.nodeMap, bit)], nil)
+		}
+		if new.dataMap&bit != 0 {
+			newEntries = append(newEntries, new.entries[indexOf(new.dataMap, bit)])
+		} else if new.nodeMap&bit != 0 {
+			newEntries = collectEntries(new.children[indexOf(new.nodeMap, bit)], nil)
+		}
+		changes = entriesDiff(oldEntries, newEntries, changes)
+	}
+	return changes
+}
+
+func entriesDiff[K comparable, V comparable](old, new []mapEntry[K, V], changes []Change[K, V]) []Change[K, V] {
+	for _, v := range old {
+		var found = false
+		for _, w := range new {
+			if v.key == w.key {
+				found = true
+				if v.value != w.value {
+					changes = append(changes, Change[K, V]{v.key, option.Some(v.value), option.Some(w.value)})
+				}
+				break
+			}
+		}
+		if !found {
+			changes = append(changes, Change[K, V]{v.key, option.Some(v.value), option.None[V]()})
+		}
+	}
+	for _, w := range new {
+		var found = false
+		for _, v := range old {
+			if v.key == w.key {
+				found = true
+				break
+			}
+		}
+		if !found {
+			changes = append(changes, Change[K, V]{w.key, option.None[V](), option.Some(w.value)})
+		}
+	}
+	return changes
+}
+
+func collectEntries[K comparable, V any](node *mapNode[K, V], entries []mapEntry[K, V]) []mapEntry[K, V] {
+	if node == nil {
+		return entries
+	}
+	entries = append(entries, node.entries...)
+	for _, v := range node.children {
+		entries = collectEntries(v, entries)
+	}
+	return entries
+}
+
+type mapIterator[K comparable, V any] struct {
+	stack   []*mapNode[K, V]
+	entries []mapEntry[K, V]
+}
+
+func (a *mapIterator[K, V]) push(node *mapNode[K, V]) {
+	a.stack = append(a.stack, node)
+}
+
+func (a *mapIterator[K, V]) Next() option.Option[dict.Entry[K, V]] {
+	for len(a.entries) == 0 {
+		if len(a.stack) == 0 {
+			return option.None[dict.Entry[K, V]]()
+		}
+		var node = a.stack[len(a.stack)-1]
+		a.stack = a.stack[:len(a.stack)-1]
+		for i := len(node.children) - 1; i >= 0; i-- {
+			a.push(node.children[i])
+		}
+		a.entries = node.entries
+	}
+	var item = a.entries[0]
+	a.entries = a.entries[1:]
+	return option.Some(dict.Entry[K, V]{Key: item.key, Value: item.value})
+}
@@ -0,0 +1,146 @@
+package persistent
+
+import (
+	"testing"
+
+	"github.com/kulics/gollection/dict"
+	"github.com/kulics/gollection/seq"
+)
+
+func TestMap(t *testing.T) {
+	var empty = MakeMap[int, int]()
+	if empty.Count() != 0 || empty.Contains(1) || empty.Remove(1).Count() != 0 {
+		t.Fatal("empty map error")
+	}
+	var m = empty
+	const length = 20000
+	for i := 0; i < length; i++ {
+		m = m.Add(i, i)
+	}
+	if m.Count() != length {
+		t.Fatal("map count error")
+	}
+	for i := 0; i < length; i++ {
+		if m.At(i).OrPanic() != i {
+			t.Fatal("map at error")
+		}
+	}
+	var updated = m.Add(100, -100)
+	if updated.Count() != length || updated.At(100).OrPanic() != -100 || m.At(100).OrPanic() != 100 {
+		t.Fatal("map update error")
+	}
+	var sum = 0
+	seq.ForEach[dict.Entry[int, int]](func(e dict.Entry[int, int]) {
+		sum += e.Value
+	}, m)
+	if sum != length*(length-1)/2 {
+		t.Fatal("map iterator error")
+	}
+	var removed = m
+	for i := 0; i < length; i += 2 {
+		removed = removed.Remove(i)
+	}
+	if removed.Count() != length/2 || removed.Contains(0) || !removed.Contains(1) || !m.Contains(0) {
+		t.Fatal("map remove error")
+	}
+	if !MapEquals(m, m.Add(1, 1)) || MapEquals(m, updated) || MapEquals(m, removed) {
+		t.Fatal("map equals error")
+	}
+	var rebuilt = removed
+	for i := 0; i < length; i += 2 {
+		rebuilt = rebuilt.Add(i, i)
+	}
+	if !MapEquals(m, rebuilt) || m.root == rebuilt.root {
+		t.Fatal("map canonical layout error")
+	}
+	var changes = MapDiff(m, updated.Remove(7).Add(-1, 1))
+	if len(changes) != 3 {
+		t.Fatal("map diff count error")
+	}
+	for _, c := range changes {
+		switch c.Key {
+		case 100:
+			if c.Old.OrPanic() != 100 || c.New.OrPanic() != -100 {
+				t.Fatal("map diff update error")
+			}
+		case 7:
+			if c.Old.OrPanic() != 7 || c.New.IsSome() {
+				t.Fatal("map diff remove error")
+			}
+		case -1:
+			if c.Old.IsSome() || c.New.OrPanic() != 1 {
+				t.Fatal("map diff add error")
+			}
+		default:
+			t.Fatal("map diff key error")
+		}
+	}
+	var other = MapFrom[int, int](m)
+	if !MapEquals(m, other) || len(MapDiff(other, updated)) != 1 {
+		t.Fatal("map with other hasher error")
+	}
+	var transient = m.Transient()
+	for i := 0; i < length; i += 2 {
+		transient.Remove(i)
+	}
+	transient.Add(-1, 1)
+	var batch = transient.Persistent()
+	if batch.Count() != length/2+1 || batch.Contains(0) || !MapEquals(batch.Remove(-1), removed) || m.Count() != length {
+		t.Fatal("map transient error")
+	}
+	var collided = MakeMapWithHasher[int, int](func(k int) uint64 { return uint64(k % 3) })
+	for i := 0; i < 30; i++ {
+		collided = collided.Add(i, i)
+	}
+	for i := 0; i < 30; i += 3 {
+		collided = collided.Remove(i)
+	}
+	if collided.Count() != 20 || collided.Contains(3) || collided.At(4).OrPanic() != 4 {
+		t.Fatal("map collision error")
+	}
+	for i := 0; i < 30; i++ {
+		collided = collided.Remove(i)
+	}
+	if collided.Count() != 0 || collided.root != nil {
+		t.Fatal("map collision remove error")
+	}
+	if MapOf(dict.Entry[int, int]{Key: 1, Value: 2}).At(1).OrPanic() != 2 {
+		t.Fatal("map of error")
+	}
+}
+
+func TestMapTransient(t *testing.T) {
+	var built = MapOf(dict.Entry[int, int]{Key: 1, Value: 1}, dict.Entry[int, int]{Key: 2, Value: 2})
+	var transient = built.Transient()
+	transient.Add(1, 100)
+	transient.Add(3, 3)
+	transient.Remove(2)
+	if built.Count() != 2 || built.At(1).OrPanic() != 1 || built.Contains(3) || !built.Contains(2) {
+		t.Fatal("transient changed built map")
+	}
+	var batch = transient.Persistent()
+	if batch.Count() != 2 || batch.At(1).OrPanic() != 100 || !batch.Contains(3) {
+		t.Fatal("map transient error")
+	}
+}
+
+func TestMapEmpty(t *testing.T) {
+	var zero Map[string, int]
+	if zero.Count() != 0 || zero.Contains("a") || zero.Remove("a").Count() != 0 {
+		t.Fatal("zero map error")
+	}
+	var added = zero.Add("a", 1).Add("b", 2)
+	if added.Count() != 2 || added.At("b").OrPanic() != 2 {
+		t.Fatal("zero map add error")
+	}
+	var removed = added.Remove("a").Remove("b")
+	if !MapEquals(removed, MakeMap[string, int]()) || !MapEquals(removed, zero) || len(MapDiff(removed, zero)) != 0 {
+		t.Fatal("empty map equals error")
+	}
+	var transient = added.Transient()
+	transient.Remove("a")
+	transient.Remove("b")
+	if !MapEquals(transient.Persistent(), removed) {
+		t.Fatal("empty transient equals error")
+	}
+}
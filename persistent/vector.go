@@ -0,0 +1,375 @@
+package persistent
+
+import (
+	"github.com/kulics/gollection/option"
+	"github.com/kulics/gollection/seq"
+)
+
+const (
+	bits  = 5
+	width = 1 << bits
+	mask  = width - 1
+)
+
+// Constructing a Vector with variable-length parameters.
+func VectorOf[T any](elements ...T) Vector[T] {
+	return VectorFrom[T](seq.Slice[T](elements))
+}
+
+// Constructing a Vector from other Sequence.
+func VectorFrom[T any](it seq.Sequence[T]) Vector[T] {
+	var builder = Vector[T]{}.Transient()
+	seq.ForEach(builder.AddLast, it)
+	return builder.Persistent()
+}
+
+// Vector is an immutable list implemented using 32-way trie with tail.
+// The operations return a new Vector sharing most of the structure with the old one,
+// so the old Vector is never changed and can be kept cheaply.
+// The zero value is an empty Vector.
+type Vector[T any] struct {
+	// The elements are at [offset, offset + length) of the trie, offset is not 0 only for a slice.
+	offset int
+	length int
+	shift  int
+	root   *vectorNode[T]
+	tail   []T
+}
+
+type vectorNode[T any] struct {
+	// The owner is used by Transient to modify the nodes it created in place.
+	owner    *owner
+	children []*vectorNode[T]
+	elements []T
+}
+
+// The owner is not zero-size, so each one has a distinct address.
+type owner struct{ _ byte }
+
+// Return the number of elements of vector.
+func (a Vector[T]) Count() int {
+	return a.length
+}
+
+// Return the element at the index.
+// Return None when a subscript is out of bounds.
+func (a Vector[T]) At(index int) option.Option[T] {
+	if index < 0 || index >= a.length {
+		return option.None[T]()
+	}
+	index += a.offset
+	return option.Some(a.leafOf(index)[index&mask])
+}
+
+// Return the element at the begin.
+// Return None when the vector is empty.
+func (a Vector[T]) First() option.Option[T] {
+	return a.At(0)
+}
+
+// Return the element at the end.
+// Return None when the vector is empty.
+func (a Vector[T]) Last() option.Option[T] {
+	return a.At(a.length - 1)
+}
+
+// Return a new vector with the element at the index replaced.
+func (a Vector[T]) Set(index int, element T) Vector[T] {
+	if index < 0 || index >= a.length {
+		panic(seq.OutOfBounds)
+	}
+	index += a.offset
+	if index >= a.tailOffset() {
+		var tail = make([]T, len(a.tail))
+		copy(tail, a.tail)
+		tail[index&mask] = element
+		return Vector[T]{a.offset, a.length, a.shift, a.root, tail}
+	}
+	return Vector[T]{a.offset, a.length, a.shift, setNode(nil, a.shift, a.root, index, element), a.tail}
+}
+
+// Return a new vector with the element added at the end.
+func (a Vector[T]) AddLast(element T) Vector[T] {
+	if a.end()-a.tailOffset() < width {
+		var tail = make([]T, len(a.tail)+1)
+		copy(tail, a.tail)
+		tail[len(a.tail)] = element
+		return Vector[T]{a.offset, a.length + 1, a.shift, a.root, tail}
+	}
+	var root, shift = pushTailOf(nil, a.end(), a.shift, a.root, &vectorNode[T]{elements: a.tail})
+	return Vector[T]{a.offset, a.length + 1, shift, root, []T{element}}
+}
+
+// Return a new vector with the element at the end removed.
+// Return the vector itself when it is empty.
+func (a Vector[T]) RemoveLast() Vector[T] {
+	switch {
+	case a.length == 0:
+		return a
+	case a.length == 1:
+		return Vector[T]{}
+	case a.end()-a.tailOffset() > 1:
+		return Vector[T]{a.offset, a.length - 1, a.shift, a.root, a.tail[:len(a.tail)-1]}
+	}
+	var tail = a.leafOf(a.end() - 2)
+	var root = popTail(nil, a.end(), a.shift, a.root)
+	var shift = a.shift
+	if root == nil {
+		shift = 0
+	} else if shift > bits && len(root.children) == 1 {
+		root = root.children[0]
+		shift -= bits
+	}
+	return Vector[T]{a.offset, a.length - 1, shift, root, tail}
+}
+
+// Return a new vector of the elements between begin and end, it shares the structure with vector.
+// Like the slices of go, the elements before begin are kept reachable by the new vector,
+// use VectorFrom to copy the elements when vector is released.
+func (a Vector[T]) Slice(begin, end int) Vector[T] {
+	if begin < 0 || end > a.length || begin > end {
+		panic(seq.OutOfBounds)
+	}
+	if begin == end {
+		return Vector[T]{}
+	}
+	var newEnd = a.offset + end
+	var result = Vector[T]{a.offset + begin, end - begin, a.shift, a.root, nil}
+	var tailOffset = result.tailOffset()
+	if tailOffset >= a.tailOffset() {
+		result.tail = a.tail[:newEnd-tailOffset]
+		return result
+	}
+	result.tail = a.leafOf(tailOffset)[:newEnd-tailOffset]
+	if tailOffset == 0 {
+		result.root, result.shift = nil, 0
+		return result
+	}
+	result.root = trimNode(a.shift, a.root, tailOffset)
+	for result.shift > bits && len(result.root.children) == 1 {
+		result.root = result.root.children[0]
+		result.shift -= bits
+	}
+	return result
+}
+
+// Return the Iterator of vector.
+func (a Vector[T]) Iterator() seq.Iterator[T] {
+	return &vectorIterator[T]{a, a.offset, nil}
+}
+
+// Return a Transient that starts with the elements of vector, for modifying in batches.
+func (a Vector[T]) Transient() *Transient[T] {
+	var tail = make([]T, len(a.tail), width)
+	copy(tail, a.tail)
+	return &Transient[T]{&owner{}, a.offset, a.length, a.shift, a.root, tail}
+}
+
+func (a Vector[T]) end() int {
+	return a.offset + a.length
+}
+
+func (a Vector[T]) tailOffset() int {
+	if a.end() < width {
+		return 0
+	}
+	return ((a.end() - 1) >> bits) << bits
+}
+
+func (a Vector[T]) leafOf(index int) []T {
+	if index >= a.tailOffset() {
+		return a.tail
+	}
+	var node = a.root
+	for level := a.shift; level > 0; level -= bits {
+		node = node.children[(index>>level)&mask]
+	}
+	return node.elements
+}
+
+// Return the node that can be modified by the owner, the node is copied when it is not owned.
+func editable[T any](edit *owner, node *vectorNode[T]) *vectorNode[T] {
+	if edit != nil && node.owner == edit {
+		return node
+	}
+	var newNode = &vectorNode[T]{owner: edit}
+	if node.children != nil {
+		newNode.children = make([]*vectorNode[T], len(node.children), width)
+		copy(newNode.children, node.children)
+	}
+	if node.elements != nil {
+		newNode.elements = make([]T, len(node.elements))
+		copy(newNode.elements, node.elements)
+	}
+	return newNode
+}
+
+func setNode[T any](edit *owner, level int, node *vectorNode[T], index int, element T) *vectorNode[T] {
+	var newNode = editable(edit, node)
+	if level == 0 {
+		newNode.elements[index&mask] = element
+	} else {
+		var i = (index >> level) & mask
+		newNode.children[i] = setNode(edit, level-bits, node.children[i], index, element)
+	}
+	return newNode
+}
+
+// Push the full tail into the tree, return the new root and shift.
+func pushTailOf[T any](edit *owner, length int, shift int, root *vectorNode[T], tail *vectorNode[T]) (*vectorNode[T], int) {
+	if root == nil {
+		return &vectorNode[T]{owner: edit, children: append(make([]*vectorNode[T], 0, width), tail)}, bits
+	}
+	if (length >> bits) > (1 << shift) {
+		// The root is full, grow the tree by one level.
+		var newRoot = &vectorNode[T]{owner: edit, children: make([]*vectorNode[T], 0, width)}
+		newRoot.children = append(newRoot.children, root, newPath(edit, shift, tail))
+		return newRoot, shift + bits
+	}
+	return pushTail(edit, length, shift, root, tail), shift
+}
+
+func pushTail[T any](edit *owner, length int, level int, parent *vectorNode[T], tail *vectorNode[T]) *vectorNode[T] {
+	var newParent = editable(edit, parent)
+	var i = ((length - 1) >> level) & mask
+	var child *vectorNode[T]
+	if level == bits {
+		child = tail
+	} else if i < len(parent.children) {
+		child = pushTail(edit, length, level-bits, parent.children[i], tail)
+	} else {
+		child = newPath(edit, level-bits, tail)
+	}
+	if i < len(newParent.children) {
+		newParent.children[i] = child
+	} else {
+		newParent.children = append(newParent.children, child)
+	}
+	return newParent
+}
+
+func newPath[T any](edit *owner, level int, node *vectorNode[T]) *vectorNode[T] {
+	if level == 0 {
+		return node
+	}
+	var path = &vectorNode[T]{owner: edit, children: make([]*vectorNode[T], 0, width)}
+	path.children = append(path.children, newPath(edit, level-bits, node))
+	return path
+}
+
+// Return the node that only keeps the leaves of the first count elements, count is a multiple of width.
+func trimNode[T any](level int, node *vectorNode[T], count int) *vectorNode[T] {
+	var last = (count - 1) >> level
+	var newNode = &vectorNode[T]{children: make([]*vectorNode[T], last+1, width)}
+	copy(newNode.children, node.children)
+	if level > bits {
+		newNode.children[last] = trimNode(level-bits, node.children[last], count-last<<level)
+	}
+	return newNode
+}
+
+// Remove the last leaf from the tree, return nil when the node becomes empty.
+func popTail[T any](edit *owner, length int, level int, node *vectorNode[T]) *vectorNode[T] {
+	var i = ((length - 2) >> level) & mask
+	if level > bits {
+		var child = popTail(edit, length, level-bits, node.children[i])
+		if child == nil && i == 0 {
+			return nil
+		}
+		var newNode = editable(edit, node)
+		if child == nil {
+			newNode.children[i] = nil
+			newNode.children = newNode.children[:i]
+		} else {
+			newNode.children[i] = child
+		}
+		return newNode
+	} else if i == 0 {
+		return nil
+	}
+	var newNode = editable(edit, node)
+	newNode.children[i] = nil
+	newNode.children = newNode.children[:i]
+	return newNode
+}
+
+// Transient is a mutable builder of Vector, it modifies the nodes it created in place.
+// It must not be used after Persistent is called.
+type Transient[T any] struct {
+	edit   *owner
+	offset int
+	length int
+	shift  int
+	root   *vectorNode[T]
+	tail   []T
+}
+
+// Return the number of elements of transient.
+func (a *Transient[T]) Count() int {
+	return a.length
+}
+
+// Add element at the end.
+func (a *Transient[T]) AddLast(element T) {
+	if a.end()-a.tailOffset() < width {
+		a.tail = append(a.tail, element)
+		a.length++
+		return
+	}
+	var tail = &vectorNode[T]{owner: a.edit, elements: a.tail}
+	a.root, a.shift = pushTailOf(a.edit, a.end(), a.shift, a.root, tail)
+	a.tail = make([]T, 1, width)
+	a.tail[0] = element
+	a.length++
+}
+
+// Replace the element at the index.
+func (a *Transient[T]) Set(index int, element T) {
+	if index < 0 || index >= a.length {
+		panic(seq.OutOfBounds)
+	}
+	index += a.offset
+	if index >= a.tailOffset() {
+		a.tail[index&mask] = element
+		return
+	}
+	a.root = setNode(a.edit, a.shift, a.root, index, element)
+}
+
+// Return a Vector of the elements, the transient must not be used after that.
+func (a *Transient[T]) Persistent() Vector[T] {
+	var tail = make([]T, len(a.tail))
+	copy(tail, a.tail)
+	var vector = Vector[T]{a.offset, a.length, a.shift, a.root, tail}
+	a.edit = nil
+	return vector
+}
+
+func (a *Transient[T]) end() int {
+	return a.offset + a.length
+}
+
+func (a *Transient[T]) tailOffset() int {
+	if a.end() < width {
+		return 0
+	}
+	return ((a.end() - 1) >> bits) << bits
+}
+
+type vectorIterator[T any] struct {
+	source Vector[T]
+	index  int
+	leaf   []T
+}
+
+func (a *vectorIterator[T]) Next() option.Option[T] {
+	if a.index >= a.source.end() {
+		return option.None[T]()
+	}
+	if a.leaf == nil || a.index&mask == 0 {
+		a.leaf = a.source.leafOf(a.index)
+	}
+	var item = a.leaf[a.index&mask]
+	a.index++
+	return option.Some(item)
+}
@@ -0,0 +1,155 @@
+package persistent
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/kulics/gollection/seq"
+)
+
+func TestVector(t *testing.T) {
+	var empty = VectorOf[int]()
+	if empty.Count() != 0 || empty.At(0).IsSome() || empty.RemoveLast().Count() != 0 {
+		t.Fatal("empty vector error")
+	}
+	var versions = []Vector[int]{empty}
+	var v = empty
+	const length = 40000
+	for i := 0; i < length; i++ {
+		v = v.AddLast(i)
+		if i%1000 == 0 {
+			versions = append(versions, v)
+		}
+	}
+	if v.Count() != length {
+		t.Fatal("vector count error")
+	}
+	for i := 0; i < length; i++ {
+		if v.At(i).OrPanic() != i {
+			t.Fatal("vector at error")
+		}
+	}
+	for n, version := range versions[1:] {
+		if version.Count() != n*1000+1 || version.Last().OrPanic() != n*1000 {
+			t.Fatal("old version changed")
+		}
+	}
+	var set = v.Set(12345, -1).Set(length-1, -2)
+	if set.At(12345).OrPanic() != -1 || set.At(length-1).OrPanic() != -2 || v.At(12345).OrPanic() != 12345 {
+		t.Fatal("vector set error")
+	}
+	var index = 0
+	seq.ForEach[int](func(item int) {
+		if item != index {
+			t.Fatal("vector iterator error")
+		}
+		index++
+	}, v)
+	if index != length {
+		t.Fatal("vector iterator count error")
+	}
+	var removed = v
+	for i := length - 1; i >= 0; i-- {
+		if removed.Last().OrPanic() != i {
+			t.Fatal("vector remove last error")
+		}
+		removed = removed.RemoveLast()
+		if removed.Count() != i {
+			t.Fatal("vector remove count error")
+		}
+		if i%777 == 0 {
+			removed = removed.AddLast(i).RemoveLast()
+			if removed.Count() > 0 && removed.Last().OrPanic() != i-1 {
+				t.Fatal("vector add after remove error")
+			}
+		}
+	}
+	if v.Count() != length || v.At(length-1).OrPanic() != length-1 {
+		t.Fatal("old version changed by remove")
+	}
+	var slice = v.Slice(100, 1200)
+	if slice.Count() != 1100 || slice.First().OrPanic() != 100 || slice.Last().OrPanic() != 1199 {
+		t.Fatal("vector slice error")
+	}
+	var transient = v.Transient()
+	for i := 0; i < 100; i++ {
+		transient.AddLast(length + i)
+		transient.Set(i*100, -i)
+	}
+	var batch = transient.Persistent()
+	if batch.Count() != length+100 || batch.At(500).OrPanic() != -5 || batch.Last().OrPanic() != length+99 {
+		t.Fatal("transient error")
+	}
+	if v.At(500).OrPanic() != 500 || v.Count() != length {
+		t.Fatal("transient changed vector")
+	}
+	if !seq.Equals[int](VectorFrom[int](seq.Slice[int]{1, 2, 3}), VectorOf(1, 2, 3)) {
+		t.Fatal("vector from error")
+	}
+	var built = VectorFrom[int](seq.Slice[int](make([]int, 100)))
+	var other = built.Transient()
+	other.Set(0, 999)
+	other.AddLast(1)
+	if built.At(0).OrPanic() != 0 || other.Persistent().At(0).OrPanic() != 999 {
+		t.Fatal("transient changed built vector")
+	}
+}
+
+func TestVectorSlice(t *testing.T) {
+	var random = rand.New(rand.NewSource(1))
+	var source = VectorFrom[int](seq.Slice[int](make([]int, 5000)))
+	for i := 0; i < source.Count(); i++ {
+		source = source.Set(i, i)
+	}
+	for n := 0; n < 200; n++ {
+		var begin = random.Intn(source.Count())
+		var end = begin + random.Intn(source.Count()-begin+1)
+		var v = source.Slice(begin, end)
+		var expected = make([]int, 0)
+		for i := begin; i < end; i++ {
+			expected = append(expected, i)
+		}
+		for k := 0; k < 100; k++ {
+			switch random.Intn(4) {
+			case 0:
+				v = v.AddLast(-k)
+				expected = append(expected, -k)
+			case 1:
+				v = v.RemoveLast()
+				if len(expected) > 0 {
+					expected = expected[:len(expected)-1]
+				}
+			case 2:
+				if len(expected) > 0 {
+					var i = random.Intn(len(expected))
+					v = v.Set(i, k)
+					expected[i] = k
+				}
+			case 3:
+				var b = random.Intn(len(expected) + 1)
+				var e = b + random.Intn(len(expected)-b+1)
+				v = v.Slice(b, e)
+				expected = append([]int{}, expected[b:e]...)
+			}
+		}
+		var transient = v.Transient()
+		transient.AddLast(1)
+		if len(expected) > 0 {
+			transient.Set(0, -1)
+		}
+		if !seq.Equals[int](v, seq.Slice[int](expected)) || v.Count() != len(expected) {
+			t.Fatal("vector slice error")
+		}
+		for i, e := range expected {
+			if v.At(i).OrPanic() != e {
+				t.Fatal("vector slice at error")
+			}
+		}
+		if batch := transient.Persistent(); batch.Count() != len(expected)+1 || batch.Last().OrPanic() != 1 {
+			t.Fatal("vector slice transient error")
+		}
+	}
+	if source.Count() != 5000 || source.At(4999).OrPanic() != 4999 || source.At(0).OrPanic() != 0 {
+		t.Fatal("slice changed vector")
+	}
+}
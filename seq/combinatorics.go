@@ -0,0 +1,303 @@
+package seq
+
+import "github.com/kulics/gollection/option"
+
+// Return the Cartesian product of two Sequences, the right Sequence is iterated again for each element of the left one.
+func Cartesian[T any, U any](left Sequence[T], right Sequence[U]) Sequence[Pair[T, U]] {
+	return cartesianSequence[T, U]{left, right}
+}
+
+type cartesianSequence[T, U any] struct {
+	left  Sequence[T]
+	right Sequence[U]
+}
+
+func (a cartesianSequence[T, U]) Iterator() Iterator[Pair[T, U]] {
+	return &cartesianIterator[T, U]{left: a.left.Iterator(), right: a.right}
+}
+
+type cartesianIterator[T any, U any] struct {
+	left      Iterator[T]
+	right     Sequence[U]
+	current   T
+	rightIter Iterator[U]
+}
+
+func (a *cartesianIterator[T, U]) Next() option.Option[Pair[T, U]] {
+	for {
+		if a.rightIter != nil {
+			if v, ok := a.rightIter.Next().Val(); ok {
+				return option.Some(Pair[T, U]{a.current, v})
+			}
+		}
+		if v, ok := a.left.Next().Val(); ok {
+			a.current = v
+			a.rightIter = a.right.Iterator()
+		} else {
+			break
+		}
+	}
+	return option.None[Pair[T, U]]()
+}
+
+// Return the Cartesian product of the Sequences as new Slices, the last Sequence changes the fastest.
+// The Sequences except the first one are iterated again each time they are exhausted.
+// The product of no Sequence is one empty Slice.
+func CartesianN[T any](its ...Sequence[T]) Sequence[Slice[T]] {
+	return cartesianNSequence[T]{its}
+}
+
+type cartesianNSequence[T any] struct {
+	seqs []Sequence[T]
+}
+
+func (a cartesianNSequence[T]) Iterator() Iterator[Slice[T]] {
+	return &cartesianNIterator[T]{seqs: a.seqs}
+}
+
+type cartesianNIterator[T any] struct {
+	seqs      []Sequence[T]
+	iterators []Iterator[T]
+	current   []T
+	started   bool
+	done      bool
+}
+
+func (a *cartesianNIterator[T]) Next() option.Option[Slice[T]] {
+	if a.done {
+		return option.None[Slice[T]]()
+	}
+	if !a.started {
+		a.started = true
+		a.iterators = make([]Iterator[T], len(a.seqs))
+		a.current = make([]T, len(a.seqs))
+		for i := range a.seqs {
+			if !a.restart(i) {
+				a.done = true
+				return option.None[Slice[T]]()
+			}
+		}
+	} else if !a.advance() {
+		a.done = true
+		return option.None[Slice[T]]()
+	}
+	var result = make([]T, len(a.current))
+	copy(result, a.current)
+	return option.Some(Slice[T](result))
+}
+
+func (a *cartesianNIterator[T]) restart(i int) bool {
+	a.iterators[i] = a.seqs[i].Iterator()
+	if v, ok := a.iterators[i].Next().Val(); ok {
+		a.current[i] = v
+		return true
+	}
+	return false
+}
+
+func (a *cartesianNIterator[T]) advance() bool {
+	for i := len(a.iterators) - 1; i >= 0; i-- {
+		if v, ok := a.iterators[i].Next().Val(); ok {
+			a.current[i] = v
+			return true
+		}
+		if i == 0 || !a.restart(i) {
+			return false
+		}
+	}
+	return false
+}
+
+// Return the permutations of k elements of the Collection as new Slices, in the lexicographic order of positions.
+// The elements are collected when the Iterator is created, and each permutation is generated when needed.
+func Permutations[T any](k int, c Collection[T]) Sequence[Slice[T]] {
+	if k < 0 {
+		panic(OutOfBounds)
+	}
+	return permutationsSequence[T]{k, c}
+}
+
+type permutationsSequence[T any] struct {
+	k          int
+	collection Collection[T]
+}
+
+func (a permutationsSequence[T]) Iterator() Iterator[Slice[T]] {
+	var elements = ToSlice(a.collection)
+	var n = len(elements)
+	var iter = &permutationsIterator[T]{elements: elements, done: a.k > n}
+	if !iter.done {
+		iter.indexes = make([]int, n)
+		for i := range iter.indexes {
+			iter.indexes[i] = i
+		}
+		iter.cycles = make([]int, a.k)
+		for i := range iter.cycles {
+			iter.cycles[i] = n - i
+		}
+	}
+	return iter
+}
+
+type permutationsIterator[T any] struct {
+	elements Slice[T]
+	indexes  []int
+	cycles   []int
+	started  bool
+	done     bool
+}
+
+func (a *permutationsIterator[T]) Next() option.Option[Slice[T]] {
+	if a.done {
+		return option.None[Slice[T]]()
+	}
+	if !a.started {
+		a.started = true
+	} else if !a.advance() {
+		a.done = true
+		return option.None[Slice[T]]()
+	}
+	return option.Some(pick(a.elements, a.indexes[:len(a.cycles)]))
+}
+
+func (a *permutationsIterator[T]) advance() bool {
+	var n = len(a.indexes)
+	for i := len(a.cycles) - 1; i >= 0; i-- {
+		a.cycles[i]--
+		if a.cycles[i] == 0 {
+			var index = a.indexes[i]
+			copy(a.indexes[i:], a.indexes[i+1:])
+			a.indexes[n-1] = index
+			a.cycles[i] = n - i
+		} else {
+			var j = n - a.cycles[i]
+			a.indexes[i], a.indexes[j] = a.indexes[j], a.indexes[i]
+			return true
+		}
+	}
+	return false
+}
+
+// Return the combinations of k elements of the Collection as new Slices, the elements keep the order of the Collection.
+// The elements are collected when the Iterator is created, and each combination is generated when needed.
+func Combinations[T any](k int, c Collection[T]) Sequence[Slice[T]] {
+	if k < 0 {
+		panic(OutOfBounds)
+	}
+	return combinationsSequence[T]{k, false, c}
+}
+
+// Return the combinations of k elements of the Collection like Combinations, but an element can be picked repeatedly.
+func CombinationsWithReplacement[T any](k int, c Collection[T]) Sequence[Slice[T]] {
+	if k < 0 {
+		panic(OutOfBounds)
+	}
+	return combinationsSequence[T]{k, true, c}
+}
+
+type combinationsSequence[T any] struct {
+	k           int
+	replacement bool
+	collection  Collection[T]
+}
+
+func (a combinationsSequence[T]) Iterator() Iterator[Slice[T]] {
+	return newCombinationsIterator(a.k, a.replacement, ToSlice(a.collection))
+}
+
+func newCombinationsIterator[T any](k int, replacement bool, elements Slice[T]) *combinationsIterator[T] {
+	var n = len(elements)
+	var iter = &combinationsIterator[T]{elements: elements, indexes: make([]int, k), replacement: replacement}
+	if replacement {
+		iter.done = n == 0 && k > 0
+	} else {
+		iter.done = k > n
+		for i := range iter.indexes {
+			iter.indexes[i] = i
+		}
+	}
+	return iter
+}
+
+type combinationsIterator[T any] struct {
+	elements    Slice[T]
+	indexes     []int
+	replacement bool
+	started     bool
+	done        bool
+}
+
+func (a *combinationsIterator[T]) Next() option.Option[Slice[T]] {
+	if a.done {
+		return option.None[Slice[T]]()
+	}
+	if !a.started {
+		a.started = true
+	} else if !a.advance() {
+		a.done = true
+		return option.None[Slice[T]]()
+	}
+	return option.Some(pick(a.elements, a.indexes))
+}
+
+func (a *combinationsIterator[T]) advance() bool {
+	var n, k = len(a.elements), len(a.indexes)
+	for i := k - 1; i >= 0; i-- {
+		if a.replacement && a.indexes[i] != n-1 {
+			var index = a.indexes[i] + 1
+			for j := i; j < k; j++ {
+				a.indexes[j] = index
+			}
+			return true
+		} else if !a.replacement && a.indexes[i] != i+n-k {
+			a.indexes[i]++
+			for j := i + 1; j < k; j++ {
+				a.indexes[j] = a.indexes[j-1] + 1
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// Return all subsets of the Collection as new Slices, from the smaller subsets to the larger ones.
+// The elements are collected when the Iterator is created, and each subset is generated when needed.
+func PowerSet[T any](c Collection[T]) Sequence[Slice[T]] {
+	return powerSetSequence[T]{c}
+}
+
+type powerSetSequence[T any] struct {
+	collection Collection[T]
+}
+
+func (a powerSetSequence[T]) Iterator() Iterator[Slice[T]] {
+	var elements = ToSlice(a.collection)
+	return &powerSetIterator[T]{elements, newCombinationsIterator(0, false, elements)}
+}
+
+type powerSetIterator[T any] struct {
+	elements Slice[T]
+	iterator *combinationsIterator[T]
+}
+
+func (a *powerSetIterator[T]) Next() option.Option[Slice[T]] {
+	for {
+		if v, ok := a.iterator.Next().Val(); ok {
+			return option.Some(v)
+		}
+		var k = len(a.iterator.indexes) + 1
+		if k > len(a.elements) {
+			break
+		}
+		a.iterator = newCombinationsIterator(k, false, a.elements)
+	}
+	return option.None[Slice[T]]()
+}
+
+func pick[T any](elements Slice[T], indexes []int) Slice[T] {
+	var result = make([]T, len(indexes))
+	for i, index := range indexes {
+		result[i] = elements[index]
+	}
+	return result
+}
@@ -0,0 +1,73 @@
+package seq
+
+import (
+	"testing"
+)
+
+func TestFlatMap(t *testing.T) {
+	var repeat = func(i int) Sequence[int] {
+		return Limit(i, Sequence[int](Slice[int]{i, i, i}))
+	}
+	if !Equals[int](Slice[int](collect(FlatMap(repeat, Sequence[int](Slice[int]{1, 0, 2})))), Slice[int]{1, 2, 2}) {
+		t.Fatal("FlatMap error")
+	}
+}
+
+func TestCartesian(t *testing.T) {
+	var pairs = collect(Cartesian(Sequence[int](Slice[int]{1, 2}), Sequence[rune](String("ab"))))
+	if len(pairs) != 4 || pairs[0] != (Pair[int, rune]{1, 'a'}) || pairs[3] != (Pair[int, rune]{2, 'b'}) {
+		t.Fatal("Cartesian error")
+	}
+	if len(collect(Cartesian(Sequence[int](Slice[int]{1}), Sequence[int](Slice[int]{})))) != 0 {
+		t.Fatal("Cartesian empty error")
+	}
+	var product = CartesianN(Sequence[int](Slice[int]{1, 2}), Sequence[int](Slice[int]{3}), Sequence[int](Slice[int]{4, 5}))
+	if !slicesEqual(collectSlices(product), [][]int{{1, 3, 4}, {1, 3, 5}, {2, 3, 4}, {2, 3, 5}}) {
+		t.Fatal("CartesianN error")
+	}
+	if !slicesEqual(collectSlices(CartesianN[int]()), [][]int{{}}) {
+		t.Fatal("CartesianN none error")
+	}
+	if len(collectSlices(CartesianN(Sequence[int](Slice[int]{1}), Sequence[int](Slice[int]{})))) != 0 {
+		t.Fatal("CartesianN empty error")
+	}
+}
+
+func TestPermutations(t *testing.T) {
+	var datas = Slice[int]{1, 2, 3}
+	if !slicesEqual(collectSlices(Permutations[int](2, datas)), [][]int{{1, 2}, {1, 3}, {2, 1}, {2, 3}, {3, 1}, {3, 2}}) {
+		t.Fatal("Permutations error")
+	}
+	if Count(Permutations[int](3, datas)) != 6 || Count(Permutations[int](4, datas)) != 0 {
+		t.Fatal("Permutations count error")
+	}
+	if !slicesEqual(collectSlices(Permutations[int](0, datas)), [][]int{{}}) {
+		t.Fatal("Permutations zero error")
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	var datas = Slice[int]{1, 2, 3}
+	if !slicesEqual(collectSlices(Combinations[int](2, datas)), [][]int{{1, 2}, {1, 3}, {2, 3}}) {
+		t.Fatal("Combinations error")
+	}
+	if Count(Combinations[int](4, datas)) != 0 || Count(Combinations[int](0, datas)) != 1 {
+		t.Fatal("Combinations count error")
+	}
+	if !slicesEqual(collectSlices(CombinationsWithReplacement[int](2, Slice[int]{1, 2})), [][]int{{1, 1}, {1, 2}, {2, 2}}) {
+		t.Fatal("CombinationsWithReplacement error")
+	}
+	if Count(CombinationsWithReplacement[int](1, Slice[int]{})) != 0 {
+		t.Fatal("CombinationsWithReplacement empty error")
+	}
+	if !slicesEqual(collectSlices(PowerSet[int](datas)), [][]int{{}, {1}, {2}, {3}, {1, 2}, {1, 3}, {2, 3}, {1, 2, 3}}) {
+		t.Fatal("PowerSet error")
+	}
+	if !slicesEqual(collectSlices(PowerSet[int](Slice[int]{})), [][]int{{}}) {
+		t.Fatal("PowerSet empty error")
+	}
+	var first = Combinations[int](30, Slice[int](make([]int, 60))).Iterator().Next()
+	if len(first.OrPanic()) != 30 {
+		t.Fatal("Combinations lazy error")
+	}
+}
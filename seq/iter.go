@@ -7,4 +7,26 @@ type Iterator[T any] interface {
 	Next() option.Option[T]
 }
 
+// MutableIterator is an Iterator that can remove the element returned by the last Next from its source.
+type MutableIterator[T any] interface {
+	Iterator[T]
+
+	// Remove the element returned by the last Next, panic with IllegalState when there is no such element.
+	Remove()
+}
+
+// StoppableIterator is an Iterator that holds resources until it is exhausted or stopped.
+type StoppableIterator[T any] interface {
+	Iterator[T]
+
+	// Release the resources, the following Next return None.
+	Stop()
+}
+
 const OutOfBounds = "out of bounds"
+
+// The panic of iterators when the source is structurally modified during iteration other than by the iterator itself.
+const ConcurrentModification = "concurrent modification"
+
+// The panic when an operation is called at an inappropriate time.
+const IllegalState = "illegal state"
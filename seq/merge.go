@@ -0,0 +1,253 @@
+package seq
+
+import "github.com/kulics/gollection/option"
+
+// Merge the Sequences sorted by less into one sorted Sequence, the equal elements keep the order of the Sequences.
+// It only keeps the current element of each Sequence in a heap.
+func MergeSorted[T any](less func(T, T) bool, its ...Sequence[T]) Sequence[T] {
+	return mergeSortedSequence[T]{less, its}
+}
+
+type mergeSortedSequence[T any] struct {
+	less func(T, T) bool
+	seqs []Sequence[T]
+}
+
+func (a mergeSortedSequence[T]) Iterator() Iterator[T] {
+	return &mergeSortedIterator[T]{less: a.less, seqs: a.seqs}
+}
+
+type mergeSortedIterator[T any] struct {
+	less      func(T, T) bool
+	seqs      []Sequence[T]
+	iterators []Iterator[T]
+	heap      []mergeHead[T]
+	started   bool
+}
+
+type mergeHead[T any] struct {
+	value  T
+	source int
+}
+
+func (a *mergeSortedIterator[T]) Next() option.Option[T] {
+	if !a.started {
+		a.started = true
+		a.iterators = make([]Iterator[T], len(a.seqs))
+		a.heap = make([]mergeHead[T], 0, len(a.seqs))
+		for i, s := range a.seqs {
+			a.iterators[i] = s.Iterator()
+			if v, ok := a.iterators[i].Next().Val(); ok {
+				a.heap = append(a.heap, mergeHead[T]{v, i})
+				a.siftUp(len(a.heap) - 1)
+			}
+		}
+	}
+	if len(a.heap) == 0 {
+		return option.None[T]()
+	}
+	var top = a.heap[0]
+	if v, ok := a.iterators[top.source].Next().Val(); ok {
+		a.heap[0] = mergeHead[T]{v, top.source}
+	} else {
+		var last = len(a.heap) - 1
+		a.heap[0] = a.heap[last]
+		a.heap = a.heap[:last]
+	}
+	a.siftDown(0)
+	return option.Some(top.value)
+}
+
+func (a *mergeSortedIterator[T]) before(i, j int) bool {
+	var l, r = a.heap[i], a.heap[j]
+	return a.less(l.value, r.value) || (!a.less(r.value, l.value) && l.source < r.source)
+}
+
+func (a *mergeSortedIterator[T]) siftUp(i int) {
+	for i > 0 {
+		var parent = (i - 1) / 2
+		if !a.before(i, parent) {
+			break
+		}
+		a.heap[i], a.heap[parent] = a.heap[parent], a.heap[i]
+		i = parent
+	}
+}
+
+func (a *mergeSortedIterator[T]) siftDown(i int) {
+	for {
+		var smallest, left, right = i, 2*i + 1, 2*i + 2
+		if left < len(a.heap) && a.before(left, smallest) {
+			smallest = left
+		}
+		if right < len(a.heap) && a.before(right, smallest) {
+			smallest = right
+		}
+		if smallest == i {
+			break
+		}
+		a.heap[i], a.heap[smallest] = a.heap[smallest], a.heap[i]
+		i = smallest
+	}
+}
+
+// Return the elements in either of the Sequences sorted by less, a pair of equal elements is returned once.
+func SortedUnion[T any](less func(T, T) bool, left Sequence[T], right Sequence[T]) Sequence[T] {
+	return sortedSetSequence[T]{less, sortedUnion, left, right}
+}
+
+// Return the elements in both of the Sequences sorted by less.
+func SortedIntersect[T any](less func(T, T) bool, left Sequence[T], right Sequence[T]) Sequence[T] {
+	return sortedSetSequence[T]{less, sortedIntersect, left, right}
+}
+
+// Return the elements in the left Sequence but not in the right Sequence, both are sorted by less.
+func SortedDifference[T any](less func(T, T) bool, left Sequence[T], right Sequence[T]) Sequence[T] {
+	return sortedSetSequence[T]{less, sortedDifference, left, right}
+}
+
+type sortedOperation int
+
+const (
+	sortedUnion sortedOperation = iota
+	sortedIntersect
+	sortedDifference
+)
+
+type sortedSetSequence[T any] struct {
+	less      func(T, T) bool
+	operation sortedOperation
+	left      Sequence[T]
+	right     Sequence[T]
+}
+
+func (a sortedSetSequence[T]) Iterator() Iterator[T] {
+	return &sortedSetIterator[T]{
+		less:      a.less,
+		operation: a.operation,
+		left:      &peekIterator[T]{iterator: a.left.Iterator()},
+		right:     &peekIterator[T]{iterator: a.right.Iterator()},
+	}
+}
+
+type sortedSetIterator[T any] struct {
+	less      func(T, T) bool
+	operation sortedOperation
+	left      *peekIterator[T]
+	right     *peekIterator[T]
+	started   bool
+}
+
+func (a *sortedSetIterator[T]) Next() option.Option[T] {
+	if !a.started {
+		a.started = true
+		a.left.pop()
+		a.right.pop()
+	}
+	for a.left.ok && a.right.ok {
+		if a.less(a.left.head, a.right.head) {
+			if v := a.left.pop(); a.operation != sortedIntersect {
+				return option.Some(v)
+			}
+		} else if a.less(a.right.head, a.left.head) {
+			if v := a.right.pop(); a.operation == sortedUnion {
+				return option.Some(v)
+			}
+		} else {
+			var v = a.left.pop()
+			a.right.pop()
+			if a.operation != sortedDifference {
+				return option.Some(v)
+			}
+		}
+	}
+	if a.left.ok && a.operation != sortedIntersect {
+		return option.Some(a.left.pop())
+	}
+	if a.right.ok && a.operation == sortedUnion {
+		return option.Some(a.right.pop())
+	}
+	return option.None[T]()
+}
+
+// Join the Sequences sorted by the keys, return the pairs of the elements with equal keys.
+// The elements of the right Sequence with the same key are buffered to pair with each element of the left Sequence.
+func SortedJoin[T any, U any, K any](less func(K, K) bool, leftKey func(T) K, rightKey func(U) K, left Sequence[T], right Sequence[U]) Sequence[Pair[T, U]] {
+	return sortedJoinSequence[T, U, K]{less, leftKey, rightKey, left, right}
+}
+
+type sortedJoinSequence[T any, U any, K any] struct {
+	less     func(K, K) bool
+	leftKey  func(T) K
+	rightKey func(U) K
+	left     Sequence[T]
+	right    Sequence[U]
+}
+
+func (a sortedJoinSequence[T, U, K]) Iterator() Iterator[Pair[T, U]] {
+	return &sortedJoinIterator[T, U, K]{
+		less:     a.less,
+		leftKey:  a.leftKey,
+		rightKey: a.rightKey,
+		left:     a.left.Iterator(),
+		right:    &peekIterator[U]{iterator: a.right.Iterator()},
+		group:    make([]U, 0),
+	}
+}
+
+type sortedJoinIterator[T any, U any, K any] struct {
+	less     func(K, K) bool
+	leftKey  func(T) K
+	rightKey func(U) K
+	left     Iterator[T]
+	right    *peekIterator[U]
+	group    []U
+	groupKey K
+	current  T
+	index    int
+	started  bool
+}
+
+func (a *sortedJoinIterator[T, U, K]) Next() option.Option[Pair[T, U]] {
+	if !a.started {
+		a.started = true
+		a.right.pop()
+	}
+	for {
+		if a.index < len(a.group) {
+			a.index++
+			return option.Some(Pair[T, U]{a.current, a.group[a.index-1]})
+		}
+		var v, ok = a.left.Next().Val()
+		if !ok {
+			break
+		}
+		var key = a.leftKey(v)
+		if len(a.group) == 0 || a.less(a.groupKey, key) {
+			a.group = a.group[:0]
+			for a.right.ok && a.less(a.rightKey(a.right.head), key) {
+				a.right.pop()
+			}
+			for a.right.ok && !a.less(key, a.rightKey(a.right.head)) {
+				a.group = append(a.group, a.right.pop())
+			}
+			a.groupKey = key
+		}
+		a.current = v
+		a.index = 0
+	}
+	return option.None[Pair[T, U]]()
+}
+
+// The head is loaded by the first pop, which returns the zero value.
+type peekIterator[T any] struct {
+	iterator Iterator[T]
+	head     T
+	ok       bool
+}
+
+func (a *peekIterator[T]) pop() T {
+	var head = a.head
+	a.head, a.ok = a.iterator.Next().Val()
+	return head
+}
@@ -0,0 +1,75 @@
+package seq
+
+import (
+	"testing"
+)
+
+var lessInt = func(l, r int) bool {
+	return l < r
+}
+
+func TestMergeSorted(t *testing.T) {
+	var merged = MergeSorted(lessInt, Sequence[int](Slice[int]{1, 4, 7}), Sequence[int](Slice[int]{}), Sequence[int](Slice[int]{2, 4, 8, 9}), Sequence[int](Slice[int]{0}))
+	for n := 0; n < 2; n++ {
+		if !Equals[int](Slice[int](collect(merged)), Slice[int]{0, 1, 2, 4, 4, 7, 8, 9}) {
+			t.Fatal("MergeSorted error")
+		}
+	}
+	if len(collect(MergeSorted[int](lessInt))) != 0 {
+		t.Fatal("MergeSorted empty error")
+	}
+	var byTens = func(l, r int) bool { return l/10 < r/10 }
+	if !Equals[int](Slice[int](collect(MergeSorted(byTens, Sequence[int](Slice[int]{11, 12}), Sequence[int](Slice[int]{10, 20})))), Slice[int]{11, 12, 10, 20}) {
+		t.Fatal("MergeSorted stable error")
+	}
+	var pulled = 0
+	var counting = Inspect(func(int) { pulled++ }, Sequence[int](Slice[int]{1, 2, 3, 4}))
+	MergeSorted(lessInt, counting, Sequence[int](Slice[int]{5})).Iterator().Next()
+	if pulled != 2 {
+		t.Fatal("MergeSorted lazy error")
+	}
+}
+
+func TestSortedSetOperations(t *testing.T) {
+	var left Sequence[int] = Slice[int]{1, 3, 4, 6, 8}
+	var right Sequence[int] = Slice[int]{2, 3, 6, 9}
+	var empty Sequence[int] = Slice[int]{}
+	if !Equals[int](Slice[int](collect(SortedUnion(lessInt, left, right))), Slice[int]{1, 2, 3, 4, 6, 8, 9}) {
+		t.Fatal("SortedUnion error")
+	}
+	if !Equals[int](Slice[int](collect(SortedIntersect(lessInt, left, right))), Slice[int]{3, 6}) {
+		t.Fatal("SortedIntersect error")
+	}
+	if !Equals[int](Slice[int](collect(SortedDifference(lessInt, left, right))), Slice[int]{1, 4, 8}) {
+		t.Fatal("SortedDifference error")
+	}
+	if !Equals[int](Slice[int](collect(SortedUnion(lessInt, empty, right))), Slice[int]{2, 3, 6, 9}) ||
+		len(collect(SortedIntersect(lessInt, left, empty))) != 0 ||
+		!Equals[int](Slice[int](collect(SortedDifference(lessInt, left, empty))), Slice[int]{1, 3, 4, 6, 8}) {
+		t.Fatal("Sorted empty error")
+	}
+}
+
+func TestSortedJoin(t *testing.T) {
+	type order struct {
+		customer int
+		item     string
+	}
+	var customers Sequence[int] = Slice[int]{1, 2, 2, 4}
+	var orders Sequence[order] = Slice[order]{{1, "a"}, {2, "b"}, {2, "c"}, {3, "d"}, {4, "e"}}
+	var joined = collect(SortedJoin(lessInt, func(i int) int { return i }, func(o order) int { return o.customer }, customers, orders))
+	var items = ""
+	for _, v := range joined {
+		if v.First != v.Second.customer {
+			t.Fatal("SortedJoin key error")
+		}
+		items += v.Second.item
+	}
+	if items != "abcbce" {
+		t.Fatal("SortedJoin error")
+	}
+	var none = SortedJoin(lessInt, func(i int) int { return i }, func(o order) int { return o.customer }, Sequence[int](Slice[int]{0, 5}), orders)
+	if len(collect(none)) != 0 {
+		t.Fatal("SortedJoin empty error")
+	}
+}
@@ -0,0 +1,175 @@
+package seq
+
+import (
+	"runtime"
+
+	"github.com/kulics/gollection/option"
+)
+
+// The number of elements folded by one task in ParFold and ParReduce.
+const parallelChunkSize = 256
+
+// Use transform to map a Sequence to another Sequence, the transform is executed by up to workers goroutines.
+// The order of the source Sequence is preserved. If workers is less than 1, GOMAXPROCS is used.
+// A panic in transform is propagated to the caller of Next.
+func ParMap[T any, R any](workers int, transform func(T) R, it Sequence[T]) Sequence[R] {
+	return parMapSequence[T, R]{workers, transform, it, true}
+}
+
+// Use transform to map a Sequence to another Sequence, the transform is executed by up to workers goroutines.
+// The results are provided in the order they are completed. If workers is less than 1, GOMAXPROCS is used.
+// A panic in transform is propagated to the caller of Next.
+func ParMapUnordered[T any, R any](workers int, transform func(T) R, it Sequence[T]) Sequence[R] {
+	return parMapSequence[T, R]{workers, transform, it, false}
+}
+
+// Use predicate to filter a Sequence to another Sequence, the predicate is executed by up to workers goroutines.
+// The order of the source Sequence is preserved.
+func ParFilter[T any](workers int, predicate func(T) bool, it Sequence[T]) Sequence[T] {
+	return parFilter(workers, predicate, it, true)
+}
+
+// Use predicate to filter a Sequence to another Sequence, the predicate is executed by up to workers goroutines.
+// The results are provided in the order they are completed.
+func ParFilterUnordered[T any](workers int, predicate func(T) bool, it Sequence[T]) Sequence[T] {
+	return parFilter(workers, predicate, it, false)
+}
+
+func parFilter[T any](workers int, predicate func(T) bool, it Sequence[T], ordered bool) Sequence[T] {
+	var tested = parMapSequence[T, Pair[T, bool]]{workers, func(v T) Pair[T, bool] {
+		return Pair[T, bool]{v, predicate(v)}
+	}, it, ordered}
+	return Map[Pair[T, bool]](func(p Pair[T, bool]) T {
+		return p.First
+	}, Filter[Pair[T, bool]](func(p Pair[T, bool]) bool {
+		return p.Second
+	}, Sequence[Pair[T, bool]](tested)))
+}
+
+// The action is executed for each element of the Sequence by up to workers goroutines.
+// There is no guarantee of the order of execution. A panic in action is propagated to the caller.
+func ParForEach[T any](workers int, action func(T), it Sequence[T]) {
+	ForEach(func(struct{}) {}, ParMapUnordered[T, struct{}](workers, func(v T) struct{} {
+		action(v)
+		return struct{}{}
+	}, it))
+}
+
+// Return the value of the final composite, the Sequence is split into contiguous parts
+// folded by up to workers goroutines, and the partial results are merged by combine in order.
+// The initial must be the identity of combine, and combine must be associative.
+func ParFold[T any, R any](workers int, initial R, operation func(R, T) R, combine func(R, R) R, it Sequence[T]) R {
+	return Fold[R](initial, combine, ParMap[Slice[T], R](workers, func(chunk Slice[T]) R {
+		return Fold[T](initial, operation, chunk)
+	}, Chunk(parallelChunkSize, it)))
+}
+
+// Return the value of the final composite, the Sequence is split into contiguous parts
+// reduced by up to workers goroutines, and the partial results are merged in order.
+// The operation must be associative.
+func ParReduce[T any](workers int, operation func(T, T) T, it Sequence[T]) option.Option[T] {
+	return Fold[option.Option[T]](option.None[T](), func(result option.Option[T], next option.Option[T]) option.Option[T] {
+		if v, ok := result.Val(); ok {
+			if n, ok := next.Val(); ok {
+				return option.Some(operation(v, n))
+			}
+			return result
+		}
+		return next
+	}, ParMap[Slice[T], option.Option[T]](workers, func(chunk Slice[T]) option.Option[T] {
+		return Reduce[T](operation, chunk)
+	}, Chunk(parallelChunkSize, it)))
+}
+
+type parMapSequence[T any, R any] struct {
+	workers   int
+	transform func(T) R
+	seq       Sequence[T]
+	ordered   bool
+}
+
+func (a parMapSequence[T, R]) Iterator() Iterator[R] {
+	var workers = a.workers
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	return &parMapIterator[T, R]{
+		workers:   workers,
+		transform: a.transform,
+		iterator:  a.seq.Iterator(),
+		ordered:   a.ordered,
+		results:   make(chan parResult[R], workers),
+		pending:   make(map[int]parResult[R]),
+	}
+}
+
+type parResult[R any] struct {
+	index      int
+	value      R
+	panicked   bool
+	panicValue any
+}
+
+// The source Iterator is only used by the goroutine calling Next,
+// and at most workers results are unreceived, so the tasks never block on sending
+// and no goroutine is leaked when the iteration is abandoned.
+type parMapIterator[T any, R any] struct {
+	workers   int
+	transform func(T) R
+	iterator  Iterator[T]
+	ordered   bool
+	results   chan parResult[R]
+	pending   map[int]parResult[R]
+	sent      int
+	yielded   int
+	finished  bool
+}
+
+func (a *parMapIterator[T, R]) Next() option.Option[R] {
+	a.fill()
+	if a.yielded == a.sent {
+		return option.None[R]()
+	}
+	var result parResult[R]
+	if a.ordered {
+		for {
+			if v, ok := a.pending[a.yielded]; ok {
+				delete(a.pending, a.yielded)
+				result = v
+				break
+			}
+			var v = <-a.results
+			a.pending[v.index] = v
+		}
+	} else {
+		result = <-a.results
+	}
+	a.yielded++
+	if result.panicked {
+		panic(result.panicValue)
+	}
+	return option.Some(result.value)
+}
+
+func (a *parMapIterator[T, R]) fill() {
+	for !a.finished && a.sent-a.yielded < a.workers {
+		if v, ok := a.iterator.Next().Val(); ok {
+			go runParTask(a.sent, v, a.transform, a.results)
+			a.sent++
+		} else {
+			a.finished = true
+		}
+	}
+}
+
+func runParTask[T any, R any](index int, value T, transform func(T) R, results chan<- parResult[R]) {
+	var result = parResult[R]{index: index, panicked: true}
+	defer func() {
+		if result.panicked {
+			result.panicValue = recover()
+		}
+		results <- result
+	}()
+	result.value = transform(value)
+	result.panicked = false
+}
@@ -0,0 +1,80 @@
+package seq
+
+import (
+	"sync/atomic"
+	"testing"
+)
+
+func TestParallel(t *testing.T) {
+	var source = make([]int, 1000)
+	for i := range source {
+		source[i] = i + 1
+	}
+	var datas Sequence[int] = Slice[int](source)
+	var toSlice = func(it Sequence[int]) Slice[int] {
+		return CollectToSlice(it.Iterator())
+	}
+	square := func(i int) int {
+		return i * i
+	}
+	if !Equals[int](toSlice(ParMap(4, square, datas)), toSlice(Map(square, datas))) {
+		t.Fatal("ParMap error")
+	}
+	if Sum(ParMapUnordered(4, square, datas)) != Sum(Map(square, datas)) {
+		t.Fatal("ParMapUnordered error")
+	}
+	if Count(ParMap[int](0, square, Slice[int]{})) != 0 {
+		t.Fatal("ParMap empty error")
+	}
+	if First(ParMap(4, square, datas)).OrPanic() != 1 {
+		t.Fatal("ParMap first error")
+	}
+	even := func(i int) bool {
+		return i%2 == 0
+	}
+	if !Equals[int](toSlice(ParFilter(4, even, datas)), toSlice(Filter(even, datas))) {
+		t.Fatal("ParFilter error")
+	}
+	if Count(ParFilterUnordered(4, even, datas)) != 500 {
+		t.Fatal("ParFilterUnordered error")
+	}
+	var sum int64
+	ParForEach(4, func(i int) {
+		atomic.AddInt64(&sum, int64(i))
+	}, datas)
+	if sum != 500500 {
+		t.Fatal("ParForEach error")
+	}
+	add := func(a, b int) int {
+		return a + b
+	}
+	if ParFold(4, 0, add, add, datas) != 500500 {
+		t.Fatal("ParFold error")
+	}
+	if ParFold(4, "", func(r string, i int) string {
+		return r + string(rune('a'+i%26))
+	}, func(a, b string) string {
+		return a + b
+	}, datas) != Fold("", func(r string, i int) string {
+		return r + string(rune('a'+i%26))
+	}, datas) {
+		t.Fatal("ParFold order error")
+	}
+	if ParReduce(4, add, datas).OrPanic() != 500500 {
+		t.Fatal("ParReduce error")
+	}
+	if ParReduce[int](4, add, Slice[int]{}).IsSome() {
+		t.Fatal("ParReduce empty error")
+	}
+	defer func() {
+		if recover() != "worker panic" {
+			t.Fatal("ParMap panic error")
+		}
+	}()
+	ForEach(func(int) {}, ParMap(4, func(i int) int {
+		if i == 500 {
+			panic("worker panic")
+		}
+		return i
+	}, datas))
+}
@@ -0,0 +1,106 @@
+//go:build go1.23
+
+package seq
+
+import (
+	"iter"
+
+	"github.com/kulics/gollection/option"
+)
+
+// Converts a Sequence to an iter.Seq, which can be used in range-over-func loops.
+func All[T any](it Sequence[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var iterator = it.Iterator()
+		for {
+			if v, ok := iterator.Next().Val(); ok {
+				if !yield(v) {
+					return
+				}
+			} else {
+				break
+			}
+		}
+	}
+}
+
+// Converts a Sequence of Pair to an iter.Seq2, the First of Pair is used as key and the Second as value.
+// Use it with Enumerate to range over indexes and elements.
+func All2[K any, V any](it Sequence[Pair[K, V]]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var iterator = it.Iterator()
+		for {
+			if v, ok := iterator.Next().Val(); ok {
+				if !yield(v.First, v.Second) {
+					return
+				}
+			} else {
+				break
+			}
+		}
+	}
+}
+
+// Converts an iter.Seq to a Sequence.
+// Each Iterator pulls from its own run of the iter.Seq, which is a StoppableIterator,
+// it leaks the run unless it is exhausted or stopped.
+func FromSeq[T any](it iter.Seq[T]) Sequence[T] {
+	return fromSeqSequence[T]{it}
+}
+
+type fromSeqSequence[T any] struct {
+	seq iter.Seq[T]
+}
+
+func (a fromSeqSequence[T]) Iterator() Iterator[T] {
+	var next, stop = iter.Pull(a.seq)
+	return &fromSeqIterator[T]{next, stop}
+}
+
+type fromSeqIterator[T any] struct {
+	next func() (T, bool)
+	stop func()
+}
+
+func (a *fromSeqIterator[T]) Next() option.Option[T] {
+	if v, ok := a.next(); ok {
+		return option.Some(v)
+	}
+	return option.None[T]()
+}
+
+func (a *fromSeqIterator[T]) Stop() {
+	a.stop()
+}
+
+// Converts an iter.Seq2 to a Sequence of Pair.
+// Each Iterator pulls from its own run of the iter.Seq2, which is a StoppableIterator,
+// it leaks the run unless it is exhausted or stopped.
+func FromSeq2[K any, V any](it iter.Seq2[K, V]) Sequence[Pair[K, V]] {
+	return fromSeq2Sequence[K, V]{it}
+}
+
+type fromSeq2Sequence[K any, V any] struct {
+	seq iter.Seq2[K, V]
+}
+
+func (a fromSeq2Sequence[K, V]) Iterator() Iterator[Pair[K, V]] {
+	var next, stop = iter.Pull2(a.seq)
+	return &fromSeq2Iterator[K, V]{next, stop}
+}
+
+type fromSeq2Iterator[K any, V any] struct {
+	next func() (K, V, bool)
+	stop func()
+}
+
+func (a *fromSeq2Iterator[K, V]) Next() option.Option[Pair[K, V]] {
+	if k, v, ok := a.next(); ok {
+		return option.Some(Pair[K, V]{k, v})
+	}
+	return option.None[Pair[K, V]]()
+}
+
+func (a *fromSeq2Iterator[K, V]) Stop() {
+	a.stop()
+}
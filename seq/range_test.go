@@ -0,0 +1,59 @@
+//go:build go1.23
+
+package seq
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestRange(t *testing.T) {
+	var datas = Slice[int]([]int{1, 2, 3, 4, 5})
+	var sum = 0
+	for v := range All[int](datas) {
+		sum += v
+	}
+	if sum != 15 {
+		t.Fatal("All error")
+	}
+	for v := range All[int](datas) {
+		if v == 3 {
+			break
+		}
+	}
+	for i, v := range All2(Enumerate[int](datas)) {
+		if datas[i] != v {
+			t.Fatal("All2 error")
+		}
+	}
+	if !slices.Equal(slices.Collect(All[int](datas)), datas) {
+		t.Fatal("All collect error")
+	}
+	if Sum(FromSeq(slices.Values([]int{1, 2, 3, 4, 5}))) != 15 {
+		t.Fatal("FromSeq error")
+	}
+	var stopped = false
+	var iterator = FromSeq(func(yield func(int) bool) {
+		defer func() {
+			stopped = true
+		}()
+		for i := 1; yield(i); i++ {
+		}
+	}).Iterator().(StoppableIterator[int])
+	if iterator.Next().OrPanic() != 1 {
+		t.Fatal("FromSeq error")
+	}
+	iterator.Stop()
+	if !stopped || iterator.Next().IsSome() {
+		t.Fatal("FromSeq stop error")
+	}
+	var pairs = FromSeq2(maps.All(map[string]int{"a": 1})).Iterator().(StoppableIterator[Pair[string, int]])
+	if v := pairs.Next().OrPanic(); v.First != "a" || v.Second != 1 {
+		t.Fatal("FromSeq2 error")
+	}
+	pairs.Stop()
+	if pairs.Next().IsSome() {
+		t.Fatal("FromSeq2 stop error")
+	}
+}
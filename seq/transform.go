@@ -1,7 +1,9 @@
 package seq
 
 import (
+	"github.com/kulics/gollection/hash"
 	"github.com/kulics/gollection/option"
+	"golang.org/x/exp/slices"
 )
 
 // Add subscripts to the incoming Sequence.
@@ -192,7 +194,7 @@ type concatSequence[T any] struct {
 }
 
 func (a concatSequence[T]) Iterator() Iterator[T] {
-	return &concatStream[T]{false, a.first.Iterator(), a.last.Iterator()}
+	return &concatStream[T]{true, a.first.Iterator(), a.last.Iterator()}
 }
 
 type concatStream[T any] struct {
@@ -246,6 +248,11 @@ func (a *flattenIterator[T, U]) Next() option.Option[U] {
 	}
 }
 
+// Use transform to map each element to a Sequence, and flatten the results into one Sequence.
+func FlatMap[T any, R any](transform func(T) Sequence[R], it Sequence[T]) Sequence[R] {
+	return Flatten[Sequence[R], R](Map(transform, it))
+}
+
 // Compress two Sequences into one Sequence. The length is the length of the shortest Sequence.
 func Zip[T any, U any](left Sequence[T], right Sequence[U]) Sequence[Pair[T, U]] {
 	return zipSequence[T, U]{left, right}
@@ -273,3 +280,276 @@ func (a *zipIterator[T, U]) Next() option.Option[Pair[T, U]] {
 	}
 	return option.None[Pair[T, U]]()
 }
+
+// Return a Sequence of the elements sorted by less, the order of equal elements is kept.
+// The elements are collected and sorted each time the Iterator is created.
+func Sorted[T any](less func(T, T) bool, it Sequence[T]) Sequence[T] {
+	return sortedSequence[T]{less, it}
+}
+
+type sortedSequence[T any] struct {
+	less func(T, T) bool
+	seq  Sequence[T]
+}
+
+func (a sortedSequence[T]) Iterator() Iterator[T] {
+	var elements = make([]T, 0)
+	ForEach(func(t T) {
+		elements = append(elements, t)
+	}, a.seq)
+	slices.SortStableFunc(elements, a.less)
+	return Slice[T](elements).Iterator()
+}
+
+// Convert an Sequence to another Sequence that stops at the first element not matching predicate.
+func TakeWhile[T any](predicate func(T) bool, it Sequence[T]) Sequence[T] {
+	return takeWhileSequence[T]{predicate, it}
+}
+
+type takeWhileSequence[T any] struct {
+	predicate func(T) bool
+	seq       Sequence[T]
+}
+
+func (a takeWhileSequence[T]) Iterator() Iterator[T] {
+	return &takeWhileIterator[T]{a.predicate, a.seq.Iterator(), false}
+}
+
+type takeWhileIterator[T any] struct {
+	predicate func(T) bool
+	iterator  Iterator[T]
+	done      bool
+}
+
+func (a *takeWhileIterator[T]) Next() option.Option[T] {
+	if !a.done {
+		if v, ok := a.iterator.Next().Val(); ok && a.predicate(v) {
+			return option.Some(v)
+		}
+		a.done = true
+	}
+	return option.None[T]()
+}
+
+// Convert an Sequence to another Sequence that skips the leading elements matching predicate.
+func DropWhile[T any](predicate func(T) bool, it Sequence[T]) Sequence[T] {
+	return dropWhileSequence[T]{predicate, it}
+}
+
+type dropWhileSequence[T any] struct {
+	predicate func(T) bool
+	seq       Sequence[T]
+}
+
+func (a dropWhileSequence[T]) Iterator() Iterator[T] {
+	return &dropWhileIterator[T]{a.predicate, a.seq.Iterator(), true}
+}
+
+type dropWhileIterator[T any] struct {
+	predicate func(T) bool
+	iterator  Iterator[T]
+	dropping  bool
+}
+
+func (a *dropWhileIterator[T]) Next() option.Option[T] {
+	if a.dropping {
+		a.dropping = false
+		for {
+			if v, ok := a.iterator.Next().Val(); ok {
+				if !a.predicate(v) {
+					return option.Some(v)
+				}
+			} else {
+				return option.None[T]()
+			}
+		}
+	}
+	return a.iterator.Next()
+}
+
+// Convert an Sequence to another Sequence of the intermediate results of Fold,
+// the initial value is not included.
+func Scan[T any, R any](initial R, operation func(R, T) R, it Sequence[T]) Sequence[R] {
+	return scanSequence[T, R]{initial, operation, it}
+}
+
+type scanSequence[T any, R any] struct {
+	initial   R
+	operation func(R, T) R
+	seq       Sequence[T]
+}
+
+func (a scanSequence[T, R]) Iterator() Iterator[R] {
+	return &scanIterator[T, R]{a.initial, a.operation, a.seq.Iterator()}
+}
+
+type scanIterator[T any, R any] struct {
+	result    R
+	operation func(R, T) R
+	iterator  Iterator[T]
+}
+
+func (a *scanIterator[T, R]) Next() option.Option[R] {
+	if v, ok := a.iterator.Next().Val(); ok {
+		a.result = a.operation(a.result, v)
+		return option.Some(a.result)
+	}
+	return option.None[R]()
+}
+
+// Convert an Sequence to another Sequence that keeps the first occurrence of each element.
+// The elements are compared by ==, use DistinctWith for the custom equality.
+func Distinct[T comparable](it Sequence[T]) Sequence[T] {
+	return DistinctBy(func(t T) T { return t }, it)
+}
+
+// Convert an Sequence to another Sequence that keeps the first element of each key.
+// The keys seen are kept in a Go map that is created each time the Iterator is created,
+// set.Set is not used because the set package imports seq.
+func DistinctBy[T any, K comparable](key func(T) K, it Sequence[T]) Sequence[T] {
+	return distinctSequence[T, K]{key, it}
+}
+
+type distinctSequence[T any, K comparable] struct {
+	key func(T) K
+	seq Sequence[T]
+}
+
+func (a distinctSequence[T, K]) Iterator() Iterator[T] {
+	return &distinctIterator[T, K]{a.key, a.seq.Iterator(), make(map[K]struct{})}
+}
+
+type distinctIterator[T any, K comparable] struct {
+	key      func(T) K
+	iterator Iterator[T]
+	seen     map[K]struct{}
+}
+
+func (a *distinctIterator[T, K]) Next() option.Option[T] {
+	for {
+		if v, ok := a.iterator.Next().Val(); ok {
+			var k = a.key(v)
+			if _, exist := a.seen[k]; !exist {
+				a.seen[k] = struct{}{}
+				return option.Some(v)
+			}
+		} else {
+			break
+		}
+	}
+	return option.None[T]()
+}
+
+// Convert an Sequence to another Sequence that keeps the first occurrence of each element,
+// the elements are compared by the hasher and the equaler, such as the ones of a Dict with custom equality.
+func DistinctWith[T any](hasher hash.Hasher[T], equaler hash.Equaler[T], it Sequence[T]) Sequence[T] {
+	return distinctWithSequence[T]{hasher, equaler, it}
+}
+
+type distinctWithSequence[T any] struct {
+	hasher  hash.Hasher[T]
+	equaler hash.Equaler[T]
+	seq     Sequence[T]
+}
+
+func (a distinctWithSequence[T]) Iterator() Iterator[T] {
+	return &distinctWithIterator[T]{a.hasher, a.equaler, a.seq.Iterator(), make(map[uint64][]T)}
+}
+
+type distinctWithIterator[T any] struct {
+	hasher   hash.Hasher[T]
+	equaler  hash.Equaler[T]
+	iterator Iterator[T]
+	seen     map[uint64][]T
+}
+
+func (a *distinctWithIterator[T]) Next() option.Option[T] {
+	for {
+		if v, ok := a.iterator.Next().Val(); ok {
+			var h = a.hasher.Hash(v)
+			if !a.contains(a.seen[h], v) {
+				a.seen[h] = append(a.seen[h], v)
+				return option.Some(v)
+			}
+		} else {
+			break
+		}
+	}
+	return option.None[T]()
+}
+
+func (a *distinctWithIterator[T]) contains(bucket []T, element T) bool {
+	for _, v := range bucket {
+		if a.equaler.Equal(v, element) {
+			return true
+		}
+	}
+	return false
+}
+
+// Convert an Sequence to another Sequence that drops the elements equal to the previous one.
+func DistinctUntilChanged[T comparable](it Sequence[T]) Sequence[T] {
+	return distinctUntilChangedSequence[T]{it}
+}
+
+type distinctUntilChangedSequence[T comparable] struct {
+	seq Sequence[T]
+}
+
+func (a distinctUntilChangedSequence[T]) Iterator() Iterator[T] {
+	return &distinctUntilChangedIterator[T]{iterator: a.seq.Iterator()}
+}
+
+type distinctUntilChangedIterator[T comparable] struct {
+	iterator Iterator[T]
+	previous T
+	started  bool
+}
+
+func (a *distinctUntilChangedIterator[T]) Next() option.Option[T] {
+	for {
+		if v, ok := a.iterator.Next().Val(); ok {
+			if !a.started || v != a.previous {
+				a.started = true
+				a.previous = v
+				return option.Some(v)
+			}
+		} else {
+			break
+		}
+	}
+	return option.None[T]()
+}
+
+// Convert an Sequence to another Sequence that calls action on each element when it is iterated,
+// it is useful for debugging the pipelines.
+func Inspect[T any](action func(T), it Sequence[T]) Sequence[T] {
+	return inspectSequence[T]{action, it}
+}
+
+// The alias of Inspect.
+func Peek[T any](action func(T), it Sequence[T]) Sequence[T] {
+	return Inspect(action, it)
+}
+
+type inspectSequence[T any] struct {
+	action func(T)
+	seq    Sequence[T]
+}
+
+func (a inspectSequence[T]) Iterator() Iterator[T] {
+	return &inspectIterator[T]{a.action, a.seq.Iterator()}
+}
+
+type inspectIterator[T any] struct {
+	action   func(T)
+	iterator Iterator[T]
+}
+
+func (a *inspectIterator[T]) Next() option.Option[T] {
+	var next = a.iterator.Next()
+	if v, ok := next.Val(); ok {
+		a.action(v)
+	}
+	return next
+}
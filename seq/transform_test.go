@@ -1,9 +1,25 @@
 package seq
 
 import (
+	"strings"
 	"testing"
+
+	"github.com/kulics/gollection/hash"
 )
 
+func TestConcat(t *testing.T) {
+	var concat = Concat[int](Slice[int]{1, 2}, Slice[int]{3})
+	var iter = concat.Iterator()
+	for _, v := range []int{1, 2, 3} {
+		if iter.Next().OrPanic() != v {
+			t.Fatal("concat error")
+		}
+	}
+	if iter.Next().IsSome() {
+		t.Fatal("concat end error")
+	}
+}
+
 func TestTransform(t *testing.T) {
 	show := func(i int) {
 		println(i)
@@ -16,3 +32,99 @@ func TestTransform(t *testing.T) {
 	}
 	ForEach(show, Map(square, Filter[int](even, Slice[int]([]int{1, 2, 3, 4, 5, 6, 7}))))
 }
+
+func TestSorted(t *testing.T) {
+	var source = Slice[int]([]int{5, 2, 8, 1})
+	var sorted = Sorted(func(l, r int) bool { return l < r }, Sequence[int](source))
+	for n := 0; n < 2; n++ {
+		var iter = sorted.Iterator()
+		for _, v := range []int{1, 2, 5, 8} {
+			if iter.Next().OrPanic() != v {
+				t.Fatal("Sorted error")
+			}
+		}
+		if iter.Next().IsSome() || source[0] != 5 {
+			t.Fatal("Sorted end error")
+		}
+	}
+}
+
+func TestConditional(t *testing.T) {
+	var datas Sequence[int] = Slice[int]{1, 2, 3, 4, 1, 2}
+	var empty Sequence[int] = Slice[int]{}
+	var small = func(i int) bool { return i < 3 }
+	if !Equals[int](Slice[int](collect(TakeWhile(small, datas))), Slice[int]{1, 2}) {
+		t.Fatal("TakeWhile error")
+	}
+	if !Equals[int](Slice[int](collect(DropWhile(small, datas))), Slice[int]{3, 4, 1, 2}) {
+		t.Fatal("DropWhile error")
+	}
+	if len(collect(TakeWhile(small, empty))) != 0 || len(collect(DropWhile(small, empty))) != 0 {
+		t.Fatal("While empty error")
+	}
+	if len(collect(DropWhile(func(int) bool { return true }, datas))) != 0 {
+		t.Fatal("DropWhile all error")
+	}
+	var pulled = 0
+	var iter = TakeWhile(small, Inspect(func(int) { pulled++ }, datas)).Iterator()
+	for iter.Next().IsSome() {
+	}
+	if iter.Next().IsSome() || pulled != 3 {
+		t.Fatal("TakeWhile termination error")
+	}
+}
+
+func TestScan(t *testing.T) {
+	var add = func(r int, t int) int { return r + t }
+	if !Equals[int](Slice[int](collect(Scan(10, add, Sequence[int](Slice[int]{1, 2, 3})))), Slice[int]{11, 13, 16}) {
+		t.Fatal("Scan error")
+	}
+	if len(collect(Scan(10, add, Sequence[int](Slice[int]{})))) != 0 {
+		t.Fatal("Scan empty error")
+	}
+	var pulled = 0
+	var first = Scan(0, add, Inspect(func(int) { pulled++ }, Sequence[int](Slice[int]{1, 2, 3}))).Iterator().Next()
+	if first.OrPanic() != 1 || pulled != 1 {
+		t.Fatal("Scan lazy error")
+	}
+}
+
+func TestDistinct(t *testing.T) {
+	var datas Sequence[int] = Slice[int]{1, 1, 2, 3, 2, 2, 1}
+	var distinct = Distinct(datas)
+	for n := 0; n < 2; n++ {
+		if !Equals[int](Slice[int](collect(distinct)), Slice[int]{1, 2, 3}) {
+			t.Fatal("Distinct error")
+		}
+	}
+	if !Equals[int](Slice[int](collect(DistinctBy(func(i int) bool { return i%2 == 0 }, datas))), Slice[int]{1, 2}) {
+		t.Fatal("DistinctBy error")
+	}
+	if !Equals[int](Slice[int](collect(DistinctUntilChanged(datas))), Slice[int]{1, 2, 3, 2, 1}) {
+		t.Fatal("DistinctUntilChanged error")
+	}
+	var empty Sequence[int] = Slice[int]{}
+	if len(collect(Distinct(empty))) != 0 || len(collect(DistinctUntilChanged(empty))) != 0 {
+		t.Fatal("Distinct empty error")
+	}
+	if !Equals[int](Slice[int](collect(DistinctUntilChanged(Sequence[int](Slice[int]{0, 0, 1})))), Slice[int]{0, 1}) {
+		t.Fatal("DistinctUntilChanged zero error")
+	}
+	var words Sequence[string] = Slice[string]{"Go", "go", "Java", "GO", "java"}
+	var insensitive = DistinctWith[string](hash.By(strings.ToLower, hash.String[string]()), hash.EqualBy(strings.ToLower), words)
+	if !Equals[string](Slice[string](collect(insensitive)), Slice[string]{"Go", "Java"}) {
+		t.Fatal("DistinctWith error")
+	}
+	var peeked = 0
+	if !Equals[int](Slice[int](collect(Peek(func(int) { peeked++ }, datas))), Slice[int](collect(datas))) || peeked != 7 {
+		t.Fatal("Peek error")
+	}
+}
+
+func collect[T any](it Sequence[T]) []T {
+	var result = make([]T, 0)
+	ForEach(func(t T) {
+		result = append(result, t)
+	}, it)
+	return result
+}
@@ -0,0 +1,225 @@
+package seq
+
+import "github.com/kulics/gollection/option"
+
+// Split the Sequence into chunks of size, the last chunk may be smaller.
+// Each chunk is a new Slice.
+func Chunk[T any](size int, it Sequence[T]) Sequence[Slice[T]] {
+	if size < 1 {
+		panic(OutOfBounds)
+	}
+	return chunkSequence[T]{size, false, it}
+}
+
+// Split the Sequence into chunks of size like Chunk, but all chunks share one buffer,
+// so a chunk is only valid until the next call of Next.
+func ChunkReusing[T any](size int, it Sequence[T]) Sequence[Slice[T]] {
+	if size < 1 {
+		panic(OutOfBounds)
+	}
+	return chunkSequence[T]{size, true, it}
+}
+
+type chunkSequence[T any] struct {
+	size  int
+	reuse bool
+	seq   Sequence[T]
+}
+
+func (a chunkSequence[T]) Iterator() Iterator[Slice[T]] {
+	return &chunkIterator[T]{a.size, a.reuse, nil, a.seq.Iterator()}
+}
+
+type chunkIterator[T any] struct {
+	size     int
+	reuse    bool
+	buffer   []T
+	iterator Iterator[T]
+}
+
+func (a *chunkIterator[T]) Next() option.Option[Slice[T]] {
+	var chunk = a.buffer[:0]
+	if !a.reuse || chunk == nil {
+		chunk = make([]T, 0, a.size)
+	}
+	for len(chunk) < a.size {
+		if v, ok := a.iterator.Next().Val(); ok {
+			chunk = append(chunk, v)
+		} else {
+			break
+		}
+	}
+	if a.reuse {
+		a.buffer = chunk
+	}
+	if len(chunk) == 0 {
+		return option.None[Slice[T]]()
+	}
+	return option.Some(Slice[T](chunk))
+}
+
+// Return the sliding windows of size over the Sequence, each window starts step elements after the previous one.
+// When partial is true, the windows at the end that are smaller than size are also returned.
+// Each window is a new Slice.
+func Window[T any](size int, step int, partial bool, it Sequence[T]) Sequence[Slice[T]] {
+	if size < 1 || step < 1 {
+		panic(OutOfBounds)
+	}
+	return windowSequence[T]{size, step, partial, false, it}
+}
+
+// Return the sliding windows like Window, but all windows share one buffer,
+// so a window is only valid until the next call of Next.
+func WindowReusing[T any](size int, step int, partial bool, it Sequence[T]) Sequence[Slice[T]] {
+	if size < 1 || step < 1 {
+		panic(OutOfBounds)
+	}
+	return windowSequence[T]{size, step, partial, true, it}
+}
+
+type windowSequence[T any] struct {
+	size    int
+	step    int
+	partial bool
+	reuse   bool
+	seq     Sequence[T]
+}
+
+func (a windowSequence[T]) Iterator() Iterator[Slice[T]] {
+	return &windowIterator[T]{
+		size:     a.size,
+		step:     a.step,
+		partial:  a.partial,
+		reuse:    a.reuse,
+		buffer:   make([]T, 0, a.size),
+		iterator: a.seq.Iterator(),
+	}
+}
+
+type windowIterator[T any] struct {
+	size     int
+	step     int
+	partial  bool
+	reuse    bool
+	started  bool
+	finished bool
+	buffer   []T
+	iterator Iterator[T]
+}
+
+func (a *windowIterator[T]) Next() option.Option[Slice[T]] {
+	if a.started {
+		// Drop the first step elements of the last window, and skip the rest of step from the source.
+		if a.step < len(a.buffer) {
+			var n = copy(a.buffer, a.buffer[a.step:])
+			a.buffer = a.buffer[:n]
+		} else {
+			for skip := a.step - len(a.buffer); skip > 0 && !a.finished; skip-- {
+				a.finished = a.iterator.Next().IsNone()
+			}
+			a.buffer = a.buffer[:0]
+		}
+	}
+	a.started = true
+	for len(a.buffer) < a.size && !a.finished {
+		if v, ok := a.iterator.Next().Val(); ok {
+			a.buffer = append(a.buffer, v)
+		} else {
+			a.finished = true
+		}
+	}
+	if len(a.buffer) == 0 || (len(a.buffer) < a.size && !a.partial) {
+		return option.None[Slice[T]]()
+	}
+	if a.reuse {
+		return option.Some(Slice[T](a.buffer))
+	}
+	var window = make([]T, len(a.buffer))
+	copy(window, a.buffer)
+	return option.Some(Slice[T](window))
+}
+
+// Return the Pairs of each element and the element after it.
+func Pairwise[T any](it Sequence[T]) Sequence[Pair[T, T]] {
+	return pairwiseSequence[T]{it}
+}
+
+type pairwiseSequence[T any] struct {
+	seq Sequence[T]
+}
+
+func (a pairwiseSequence[T]) Iterator() Iterator[Pair[T, T]] {
+	var iterator = a.seq.Iterator()
+	return &pairwiseIterator[T]{iterator.Next(), iterator}
+}
+
+type pairwiseIterator[T any] struct {
+	last     option.Option[T]
+	iterator Iterator[T]
+}
+
+func (a *pairwiseIterator[T]) Next() option.Option[Pair[T, T]] {
+	if last, ok := a.last.Val(); ok {
+		a.last = a.iterator.Next()
+		if v, ok := a.last.Val(); ok {
+			return option.Some(Pair[T, T]{last, v})
+		}
+	}
+	return option.None[Pair[T, T]]()
+}
+
+// Split the Sequence into runs of adjacent elements that have the same key.
+// Each run is a new Slice.
+func ChunkBy[T any, K comparable](key func(T) K, it Sequence[T]) Sequence[Slice[T]] {
+	return chunkBySequence[T, K]{key, false, it}
+}
+
+// Split the Sequence into runs like ChunkBy, but all runs share one buffer,
+// so a run is only valid until the next call of Next.
+func ChunkByReusing[T any, K comparable](key func(T) K, it Sequence[T]) Sequence[Slice[T]] {
+	return chunkBySequence[T, K]{key, true, it}
+}
+
+type chunkBySequence[T any, K comparable] struct {
+	key   func(T) K
+	reuse bool
+	seq   Sequence[T]
+}
+
+func (a chunkBySequence[T, K]) Iterator() Iterator[Slice[T]] {
+	var iterator = a.seq.Iterator()
+	return &chunkByIterator[T, K]{a.key, a.reuse, nil, iterator.Next(), iterator}
+}
+
+type chunkByIterator[T any, K comparable] struct {
+	key      func(T) K
+	reuse    bool
+	buffer   []T
+	pending  option.Option[T]
+	iterator Iterator[T]
+}
+
+func (a *chunkByIterator[T, K]) Next() option.Option[Slice[T]] {
+	var first, ok = a.pending.Val()
+	if !ok {
+		return option.None[Slice[T]]()
+	}
+	var run = a.buffer[:0]
+	if !a.reuse {
+		run = nil
+	}
+	run = append(run, first)
+	var runKey = a.key(first)
+	for {
+		a.pending = a.iterator.Next()
+		if v, ok := a.pending.Val(); ok && a.key(v) == runKey {
+			run = append(run, v)
+		} else {
+			break
+		}
+	}
+	if a.reuse {
+		a.buffer = run
+	}
+	return option.Some(Slice[T](run))
+}
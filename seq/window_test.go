@@ -0,0 +1,100 @@
+package seq
+
+import (
+	"testing"
+)
+
+func collectSlices[T comparable](it Sequence[Slice[T]]) [][]T {
+	var result = make([][]T, 0)
+	ForEach(func(s Slice[T]) {
+		result = append(result, s)
+	}, it)
+	return result
+}
+
+func slicesEqual[T comparable](l [][]T, r [][]T) bool {
+	if len(l) != len(r) {
+		return false
+	}
+	for i := range l {
+		if !Equals[T](Slice[T](l[i]), Slice[T](r[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestChunk(t *testing.T) {
+	var datas Sequence[int] = Slice[int]{1, 2, 3, 4, 5}
+	if !slicesEqual(collectSlices(Chunk(2, datas)), [][]int{{1, 2}, {3, 4}, {5}}) {
+		t.Fatal("Chunk error")
+	}
+	if len(collectSlices(Chunk(2, Sequence[int](Slice[int]{})))) != 0 {
+		t.Fatal("Chunk empty error")
+	}
+	var sums = make([]int, 0)
+	ForEach(func(s Slice[int]) {
+		sums = append(sums, Sum[int](s))
+	}, ChunkReusing(2, datas))
+	if !Equals[int](Slice[int](sums), Slice[int]{3, 7, 5}) {
+		t.Fatal("ChunkReusing error")
+	}
+	var chunks = collectSlices(ChunkReusing(2, datas))
+	if &chunks[0][0] != &chunks[1][0] {
+		t.Fatal("ChunkReusing buffer error")
+	}
+}
+
+func TestWindow(t *testing.T) {
+	var datas Sequence[int] = Slice[int]{1, 2, 3, 4, 5}
+	if !slicesEqual(collectSlices(Window(3, 1, false, datas)), [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}) {
+		t.Fatal("Window error")
+	}
+	if !slicesEqual(collectSlices(Window(3, 2, true, datas)), [][]int{{1, 2, 3}, {3, 4, 5}, {5}}) {
+		t.Fatal("Window partial error")
+	}
+	if !slicesEqual(collectSlices(Window(2, 3, false, datas)), [][]int{{1, 2}, {4, 5}}) {
+		t.Fatal("Window skip error")
+	}
+	if !slicesEqual(collectSlices(Window(6, 1, false, datas)), [][]int{}) {
+		t.Fatal("Window too large error")
+	}
+	var sums = make([]int, 0)
+	ForEach(func(s Slice[int]) {
+		sums = append(sums, Sum[int](s))
+	}, WindowReusing(2, 1, true, datas))
+	if !Equals[int](Slice[int](sums), Slice[int]{3, 5, 7, 9, 5}) {
+		t.Fatal("WindowReusing error")
+	}
+}
+
+func TestPairwise(t *testing.T) {
+	var result = make([]Pair[int, int], 0)
+	ForEach(func(p Pair[int, int]) {
+		result = append(result, p)
+	}, Pairwise(Sequence[int](Slice[int]{1, 2, 3})))
+	if len(result) != 2 || result[0] != (Pair[int, int]{1, 2}) || result[1] != (Pair[int, int]{2, 3}) {
+		t.Fatal("Pairwise error")
+	}
+	if Pairwise(Sequence[int](Slice[int]{1})).Iterator().Next().IsSome() {
+		t.Fatal("Pairwise single error")
+	}
+}
+
+func TestChunkBy(t *testing.T) {
+	var datas Sequence[int] = Slice[int]{1, 3, 2, 4, 6, 5}
+	var odd = func(i int) bool { return i%2 == 1 }
+	if !slicesEqual(collectSlices(ChunkBy(odd, datas)), [][]int{{1, 3}, {2, 4, 6}, {5}}) {
+		t.Fatal("ChunkBy error")
+	}
+	var counts = make([]int, 0)
+	ForEach(func(s Slice[int]) {
+		counts = append(counts, len(s))
+	}, ChunkByReusing(odd, datas))
+	if !Equals[int](Slice[int](counts), Slice[int]{2, 3, 1}) {
+		t.Fatal("ChunkByReusing error")
+	}
+	if len(collectSlices(ChunkBy(odd, Sequence[int](Slice[int]{})))) != 0 {
+		t.Fatal("ChunkBy empty error")
+	}
+}
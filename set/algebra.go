@@ -0,0 +1,124 @@
+package set
+
+import (
+	"github.com/kulics/gollection/dict"
+	"github.com/kulics/gollection/seq"
+)
+
+// Return a new set that contains the elements in either set.
+// The new sets of the operations have the same hashing and equality of elements as this set.
+func (a *Set[T]) Union(other *Set[T]) *Set[T] {
+	var result = a.Clone()
+	result.UnionWith(other)
+	return result
+}
+
+// Return a new set that contains the elements in both sets.
+func (a *Set[T]) Intersection(other *Set[T]) *Set[T] {
+	var result = a.cloneEmpty()
+	seq.ForEach(func(t T) {
+		result.Add(t)
+	}, a.IntersectionView(other))
+	return result
+}
+
+// Return a new set that contains the elements in this set but not in the other set.
+func (a *Set[T]) Difference(other *Set[T]) *Set[T] {
+	var result = a.cloneEmpty()
+	seq.ForEach(func(t T) {
+		result.Add(t)
+	}, a.DifferenceView(other))
+	return result
+}
+
+// Return a new set that contains the elements in exactly one of the sets.
+func (a *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	var result = a.cloneEmpty()
+	seq.ForEach(func(t T) {
+		result.Add(t)
+	}, a.SymmetricDifferenceView(other))
+	return result
+}
+
+// Return an empty set with the same hashing and equality of elements.
+func (a *Set[T]) cloneEmpty() *Set[T] {
+	return (*Set[T])((*dict.Dict[T, void])(a).CloneEmpty(0))
+}
+
+// Add all elements of the other set to this set.
+func (a *Set[T]) UnionWith(other *Set[T]) {
+	seq.ForEach[T](func(t T) {
+		a.Add(t)
+	}, other)
+}
+
+// Remove the elements that are not in the other set from this set.
+func (a *Set[T]) IntersectWith(other *Set[T]) {
+	a.RemoveIf(func(t T) bool {
+		return !other.Contains(t)
+	})
+}
+
+// Remove the elements that are in the other set from this set.
+func (a *Set[T]) ExceptWith(other *Set[T]) {
+	if a == other {
+		a.Clear()
+		return
+	}
+	seq.ForEach[T](func(t T) {
+		a.Remove(t)
+	}, other)
+}
+
+// Returns true if all elements of this set are in the other set.
+func (a *Set[T]) IsSubsetOf(other *Set[T]) bool {
+	return a.Count() <= other.Count() && other.ContainsAll(a)
+}
+
+// Returns true if all elements of the other set are in this set.
+func (a *Set[T]) IsSupersetOf(other *Set[T]) bool {
+	return other.IsSubsetOf(a)
+}
+
+// Returns true if the sets have no element in common.
+func (a *Set[T]) IsDisjoint(other *Set[T]) bool {
+	return a.IntersectionView(other).Iterator().Next().IsNone()
+}
+
+// Returns true if the sets contain the same elements.
+func (a *Set[T]) SetEquals(other *Set[T]) bool {
+	return a.Count() == other.Count() && other.ContainsAll(a)
+}
+
+// Return a lazy Sequence of the elements in either set.
+// It iterates the larger set, then the elements of the smaller set that are not in the larger set.
+func (a *Set[T]) UnionView(other *Set[T]) seq.Sequence[T] {
+	var larger, smaller = a, other
+	if larger.Count() < smaller.Count() {
+		larger, smaller = smaller, larger
+	}
+	return seq.Concat[T](larger, seq.Filter[T](func(t T) bool {
+		return !larger.Contains(t)
+	}, smaller))
+}
+
+// Return a lazy Sequence of the elements in both sets, it only iterates the smaller set.
+func (a *Set[T]) IntersectionView(other *Set[T]) seq.Sequence[T] {
+	var larger, smaller = a, other
+	if larger.Count() < smaller.Count() {
+		larger, smaller = smaller, larger
+	}
+	return seq.Filter[T](larger.Contains, smaller)
+}
+
+// Return a lazy Sequence of the elements in this set but not in the other set.
+func (a *Set[T]) DifferenceView(other *Set[T]) seq.Sequence[T] {
+	return seq.Filter[T](func(t T) bool {
+		return !other.Contains(t)
+	}, a)
+}
+
+// Return a lazy Sequence of the elements in exactly one of the sets.
+func (a *Set[T]) SymmetricDifferenceView(other *Set[T]) seq.Sequence[T] {
+	return seq.Concat(a.DifferenceView(other), other.DifferenceView(a))
+}
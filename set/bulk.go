@@ -0,0 +1,43 @@
+package set
+
+import (
+	"github.com/kulics/gollection/dict"
+	"github.com/kulics/gollection/seq"
+)
+
+// Remove the elements that satisfy predicate in one pass, return the number of removed elements.
+func (a *Set[T]) RemoveIf(predicate func(T) bool) int {
+	return (*dict.Dict[T, void])(a).RemoveIf(func(k T, v void) bool {
+		return predicate(k)
+	})
+}
+
+// Replace each element with the result of transform, return the number of changed elements.
+// The elements that become equal are merged into one.
+func (a *Set[T]) ReplaceAll(transform func(T) T) int {
+	var elements = seq.ToSlice[T](a)
+	var d = (*dict.Dict[T, void])(a)
+	var changed = 0
+	for i, v := range elements {
+		if elements[i] = transform(v); !d.KeyEquals(elements[i], v) {
+			changed++
+		}
+	}
+	if changed > 0 {
+		a.Clear()
+		for _, v := range elements {
+			a.Add(v)
+		}
+	}
+	return changed
+}
+
+// Remove the elements that are included in elements, return the number of removed elements.
+func (a *Set[T]) RemoveAll(elements seq.Collection[T]) int {
+	return (*dict.Dict[T, void])(a).RemoveAll(elements)
+}
+
+// Remove the elements that are not included in elements, return the number of removed elements.
+func (a *Set[T]) RetainAll(elements seq.Collection[T]) int {
+	return (*dict.Dict[T, void])(a).RetainAll(elements)
+}
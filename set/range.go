@@ -0,0 +1,24 @@
+//go:build go1.23
+
+package set
+
+import (
+	"iter"
+
+	"github.com/kulics/gollection/dict"
+)
+
+// Return an iter.Seq of the elements of set, which can be used in range-over-func loops.
+func (a *Set[T]) All() iter.Seq[T] {
+	return (*dict.Dict[T, void])(a).Keys()
+}
+
+// Return an iter.Seq of the elements of set, same as All.
+func (a *Set[T]) Keys() iter.Seq[T] {
+	return (*dict.Dict[T, void])(a).Keys()
+}
+
+// Return an iter.Seq of the elements of set, same as All.
+func (a *Set[T]) Values() iter.Seq[T] {
+	return (*dict.Dict[T, void])(a).Keys()
+}
@@ -23,6 +23,12 @@ func MakeWithHasher[T comparable](hasher func(data T) uint64, capacity int) *Set
 	return (*Set[T])(dict.MakeWithHasher[T, void](hasher, capacity))
 }
 
+// Constructing a Set with custom hashing and equality of elements, elements that are equal must have the same hash.
+// The == operator is used when equal is nil.
+func MakeWithEqualer[T comparable](hasher func(data T) uint64, equal func(T, T) bool, capacity int) *Set[T] {
+	return (*Set[T])(dict.MakeWithEqualer[T, void](hasher, equal, capacity))
+}
+
 func From[T comparable](collection seq.Collection[T]) *Set[T] {
 	var length = collection.Count()
 	var set = Make[T](length)
@@ -38,13 +44,14 @@ func (a *Set[T]) Count() int {
 	return (*dict.Dict[T, void])(a).Count()
 }
 
+// Add the element, returns true if the element was already included, the same as Dict returning the old value.
 func (a *Set[T]) Add(element T) bool {
 	return (*dict.Dict[T, void])(a).Add(element, void{}).IsSome()
 }
 
 func (a *Set[T]) Remove(element T) option.Option[T] {
 	if (*dict.Dict[T, void])(a).Remove(element).IsSome() {
-		option.Some(element)
+		return option.Some(element)
 	}
 	return option.None[T]()
 }
@@ -68,25 +75,15 @@ func (a *Set[T]) Clear() {
 	(*dict.Dict[T, void])(a).Clear()
 }
 
+// Return the Iterator of set, it implements seq.MutableIterator.
 func (a *Set[T]) Iterator() seq.Iterator[T] {
-	return &hashSetIterator[T]{(*dict.Dict[T, void])(a).Iterator()}
+	return (*dict.Dict[T, void])(a).KeySet().Iterator()
 }
 
 func (a *Set[T]) Clone() *Set[T] {
 	return (*Set[T])((*dict.Dict[T, void])(a).Clone())
 }
 
-type hashSetIterator[T comparable] struct {
-	it seq.Iterator[dict.Entry[T, void]]
-}
-
-func (a *hashSetIterator[T]) Next() option.Option[T] {
-	if v, ok := a.it.Next().Val(); ok {
-		return option.Some(v.Key)
-	}
-	return option.None[T]()
-}
-
 func Collector[T comparable]() seq.Collector[*Set[T], T, *Set[T]] {
 	return collector[T]{}
 }
@@ -1,7 +1,138 @@
 package set
 
-import "testing"
+import (
+	"strings"
+	"testing"
+
+	"github.com/kulics/gollection/hash"
+	"github.com/kulics/gollection/internal/panics"
+	"github.com/kulics/gollection/seq"
+)
 
 func TestHashSet(t *testing.T) {
-	var _ = Of[int]()
+	var set = Of[int]()
+	if set.Add(1) || set.Add(2) {
+		t.Fatal("set add error")
+	}
+	if !set.Add(1) || set.Count() != 2 {
+		t.Fatal("set add exist element error")
+	}
+	var a = Of(1, 2, 3, 4)
+	var iter = a.Iterator().(seq.MutableIterator[int])
+	for {
+		if v, ok := iter.Next().Val(); ok {
+			if v > 2 {
+				iter.Remove()
+			}
+		} else {
+			break
+		}
+	}
+	if !a.SetEquals(Of(1, 2)) {
+		t.Fatal("iterator remove error")
+	}
+	iter = a.Iterator().(seq.MutableIterator[int])
+	a.Add(5)
+	if panics.Recover(func() { iter.Next() }) != seq.ConcurrentModification {
+		t.Fatal("fail fast error")
+	}
+}
+
+func TestSetRemove(t *testing.T) {
+	var set = Of(1, 2)
+	if set.Remove(1).OrPanic() != 1 || set.Remove(1).IsSome() || set.Count() != 1 || set.Contains(1) {
+		t.Fatal("set remove error")
+	}
+}
+
+func TestSetAlgebra(t *testing.T) {
+	var a = Of(1, 2, 3, 4)
+	var b = Of(3, 4, 5)
+	if !a.Union(b).SetEquals(Of(1, 2, 3, 4, 5)) {
+		t.Fatal("union error")
+	}
+	if !a.Intersection(b).SetEquals(Of(3, 4)) {
+		t.Fatal("intersection error")
+	}
+	if !a.Difference(b).SetEquals(Of(1, 2)) {
+		t.Fatal("difference error")
+	}
+	if !a.SymmetricDifference(b).SetEquals(Of(1, 2, 5)) {
+		t.Fatal("symmetric difference error")
+	}
+	if seq.Count(a.UnionView(b)) != 5 || seq.Count(a.IntersectionView(b)) != 2 {
+		t.Fatal("view count error")
+	}
+	if seq.Count(a.DifferenceView(b)) != 2 || seq.Count(a.SymmetricDifferenceView(b)) != 3 {
+		t.Fatal("view count error")
+	}
+	if !Of(3, 4).IsSubsetOf(a) || a.IsSubsetOf(b) || !a.IsSupersetOf(Of(1)) {
+		t.Fatal("subset error")
+	}
+	if a.IsDisjoint(b) || !a.IsDisjoint(Of(7, 8)) {
+		t.Fatal("disjoint error")
+	}
+	var c = a.Clone()
+	c.UnionWith(b)
+	if c.Count() != 5 || a.Count() != 4 {
+		t.Fatal("union with error")
+	}
+	c.IntersectWith(b)
+	if !c.SetEquals(b) {
+		t.Fatal("intersect with error")
+	}
+	c.ExceptWith(Of(3))
+	if !c.SetEquals(Of(4, 5)) {
+		t.Fatal("except with error")
+	}
+	c.Add(6)
+	if !c.SetEquals(Of(4, 5, 6)) {
+		t.Fatal("add after remove error")
+	}
+	c.ExceptWith(c)
+	if c.Count() != 0 {
+		t.Fatal("except with self error")
+	}
+}
+
+func TestSetEqualer(t *testing.T) {
+	var insensitive = MakeWithEqualer(
+		hash.By(strings.ToLower, hash.String[string]()).Hash,
+		hash.EqualBy(strings.ToLower).Equal,
+		0,
+	)
+	if insensitive.Add("Go") || !insensitive.Add("GO") || !insensitive.Contains("go") || insensitive.Count() != 1 {
+		t.Fatal("equaler error")
+	}
+	var other = MakeWithEqualer(
+		hash.By(strings.ToLower, hash.String[string]()).Hash,
+		hash.EqualBy(strings.ToLower).Equal,
+		0,
+	)
+	other.Add("go")
+	other.Add("Rust")
+	other.Add("Zig")
+	if !insensitive.Intersection(other).Contains("gO") || !insensitive.Union(other).Contains("RUST") ||
+		!other.Difference(insensitive).Contains("zig") || !insensitive.SymmetricDifference(other).Contains("ZIG") {
+		t.Fatal("equaler algebra error")
+	}
+	if other.ReplaceAll(strings.ToUpper) != 0 || !other.Contains("rust") {
+		t.Fatal("equaler replace all error")
+	}
+}
+
+func TestSetBulk(t *testing.T) {
+	var a = Of(1, 2, 3, 4, 5, 6)
+	if a.RemoveIf(func(i int) bool { return i > 4 }) != 2 || !a.SetEquals(Of(1, 2, 3, 4)) {
+		t.Fatal("remove if error")
+	}
+	if a.ReplaceAll(func(i int) int { return i / 2 }) != 4 || !a.SetEquals(Of(0, 1, 2)) {
+		t.Fatal("replace all error")
+	}
+	if a.RemoveAll(Of(2, 5)) != 1 || !a.SetEquals(Of(0, 1)) {
+		t.Fatal("remove all error")
+	}
+	if a.RetainAll(Of(1, 5)) != 1 || !a.SetEquals(Of(1)) {
+		t.Fatal("retain all error")
+	}
 }
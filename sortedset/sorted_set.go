@@ -0,0 +1,234 @@
+package sortedset
+
+import (
+	"github.com/kulics/gollection/dict"
+	"github.com/kulics/gollection/option"
+	"github.com/kulics/gollection/seq"
+	"github.com/kulics/gollection/treemap"
+	"golang.org/x/exp/constraints"
+)
+
+// Constructing a Set with variable-length parameters.
+func Of[T constraints.Ordered](elements ...T) *Set[T] {
+	var set = Make[T]()
+	for _, v := range elements {
+		set.Add(v)
+	}
+	return set
+}
+
+// Constructing an empty Set ordered by the natural order of elements.
+func Make[T constraints.Ordered]() *Set[T] {
+	return (*Set[T])(treemap.Make[T, void]())
+}
+
+// Constructing an empty Set ordered by comparator.
+// The comparator returns a negative number when a < b, zero when a == b, and a positive number when a > b.
+func MakeWithComparator[T any](comparator func(a, b T) int) *Set[T] {
+	return (*Set[T])(treemap.MakeWithComparator[T, void](comparator))
+}
+
+// Constructing a Set from other Collection.
+func From[T constraints.Ordered](collection seq.Collection[T]) *Set[T] {
+	var set = Make[T]()
+	seq.ForEach[T](func(t T) {
+		set.Add(t)
+	}, collection)
+	return set
+}
+
+// Set implemented using TreeMap, the elements are kept in order and can be queried by rank.
+type Set[T any] treemap.TreeMap[T, void]
+
+func (a *Set[T]) tree() *treemap.TreeMap[T, void] {
+	return (*treemap.TreeMap[T, void])(a)
+}
+
+// Return the number of elements of set.
+func (a *Set[T]) Count() int {
+	return a.tree().Count()
+}
+
+// Add the element, returns true if the element was already included, the same as set.Set.
+func (a *Set[T]) Add(element T) bool {
+	return a.tree().Add(element, void{}).IsSome()
+}
+
+// Remove the element, return the element when it has been included.
+func (a *Set[T]) Remove(element T) option.Option[T] {
+	if a.tree().Remove(element).IsSome() {
+		return option.Some(element)
+	}
+	return option.None[T]()
+}
+
+// Returns true if the element is included in the set.
+func (a *Set[T]) Contains(element T) bool {
+	return a.tree().Contains(element)
+}
+
+// Returns true if all the elements are included in the set.
+func (a *Set[T]) ContainsAll(elements seq.Collection[T]) bool {
+	var iter = elements.Iterator()
+	for item, ok := iter.Next().Val(); ok; item, ok = iter.Next().Val() {
+		if !a.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clears all elements.
+func (a *Set[T]) Clear() {
+	a.tree().Clear()
+}
+
+// Return the least element.
+// Return None when the set is empty.
+func (a *Set[T]) First() option.Option[T] {
+	return keyOf(a.tree().FirstEntry())
+}
+
+// Return the greatest element.
+// Return None when the set is empty.
+func (a *Set[T]) Last() option.Option[T] {
+	return keyOf(a.tree().LastEntry())
+}
+
+// Return the greatest element less than or equal to the element.
+func (a *Set[T]) Floor(element T) option.Option[T] {
+	return keyOf(a.tree().Floor(element))
+}
+
+// Return the least element greater than or equal to the element.
+func (a *Set[T]) Ceiling(element T) option.Option[T] {
+	return keyOf(a.tree().Ceiling(element))
+}
+
+// Return the greatest element strictly less than the element.
+func (a *Set[T]) Lower(element T) option.Option[T] {
+	return keyOf(a.tree().Lower(element))
+}
+
+// Return the least element strictly greater than the element.
+func (a *Set[T]) Higher(element T) option.Option[T] {
+	return keyOf(a.tree().Higher(element))
+}
+
+// Return the number of elements strictly less than the element.
+func (a *Set[T]) Rank(element T) int {
+	return a.tree().Rank(element)
+}
+
+// Return the element at the index in ascending order, Select(0) is the least element.
+// Return None when the index is out of bounds.
+func (a *Set[T]) Select(index int) option.Option[T] {
+	return keyOf(a.tree().Select(index))
+}
+
+// Return a view of the elements between from (inclusive) and to (exclusive).
+// The view reflects the changes of set.
+func (a *Set[T]) SubSet(from, to T) *SubSet[T] {
+	return &SubSet[T]{a, from, to}
+}
+
+// Return the Iterator of set, the elements are in ascending order, it implements seq.MutableIterator.
+func (a *Set[T]) Iterator() seq.Iterator[T] {
+	return iteratorOf[T](a.tree().Iterator())
+}
+
+// Return a Sequence of the elements in descending order.
+func (a *Set[T]) Reversed() seq.Sequence[T] {
+	return keySequence[T]{a.tree().Reversed()}
+}
+
+// Return a new set that copies all elements.
+func (a *Set[T]) Clone() *Set[T] {
+	return (*Set[T])(a.tree().Clone())
+}
+
+// SubSet is a view of the elements of Set in a range.
+type SubSet[T any] struct {
+	source *Set[T]
+	from   T
+	to     T
+}
+
+// Return the number of elements in the range.
+func (a *SubSet[T]) Count() int {
+	var count = a.source.Rank(a.to) - a.source.Rank(a.from)
+	if count < 0 {
+		return 0
+	}
+	return count
+}
+
+// Returns true if the element is in the range and included in the set.
+func (a *SubSet[T]) Contains(element T) bool {
+	if !a.source.Contains(element) {
+		return false
+	}
+	var rank = a.source.Rank(element)
+	return rank >= a.source.Rank(a.from) && rank < a.source.Rank(a.to)
+}
+
+// Return the Iterator of the elements in the range in ascending order.
+func (a *SubSet[T]) Iterator() seq.Iterator[T] {
+	return iteratorOf[T](a.source.tree().Range(a.from, a.to).Iterator())
+}
+
+func keyOf[T any](entry option.Option[dict.Entry[T, void]]) option.Option[T] {
+	if v, ok := entry.Val(); ok {
+		return option.Some(v.Key)
+	}
+	return option.None[T]()
+}
+
+type keySequence[T any] struct {
+	seq seq.Sequence[dict.Entry[T, void]]
+}
+
+func (a keySequence[T]) Iterator() seq.Iterator[T] {
+	return iteratorOf[T](a.seq.Iterator())
+}
+
+// The iterators of tree implement seq.MutableIterator.
+func iteratorOf[T any](it seq.Iterator[dict.Entry[T, void]]) *iterator[T] {
+	return &iterator[T]{it.(seq.MutableIterator[dict.Entry[T, void]])}
+}
+
+type iterator[T any] struct {
+	it seq.MutableIterator[dict.Entry[T, void]]
+}
+
+func (a *iterator[T]) Remove() {
+	a.it.Remove()
+}
+
+func (a *iterator[T]) Next() option.Option[T] {
+	if v, ok := a.it.Next().Val(); ok {
+		return option.Some(v.Key)
+	}
+	return option.None[T]()
+}
+
+func Collector[T constraints.Ordered]() seq.Collector[*Set[T], T, *Set[T]] {
+	return collector[T]{}
+}
+
+type collector[T constraints.Ordered] struct{}
+
+func (a collector[T]) Builder() *Set[T] {
+	return Make[T]()
+}
+
+func (a collector[T]) Append(supplier *Set[T], element T) {
+	supplier.Add(element)
+}
+
+func (a collector[T]) Finish(supplier *Set[T]) *Set[T] {
+	return supplier
+}
+
+// Indicates the type of empty.
+type void struct{}
@@ -0,0 +1,91 @@
+package sortedset
+
+import (
+	"testing"
+
+	"github.com/kulics/gollection/internal/panics"
+	"github.com/kulics/gollection/seq"
+)
+
+func TestSortedSet(t *testing.T) {
+	var set = Of[int]()
+	if set.Count() != 0 {
+		t.Fatal("set count not eq 0")
+	}
+	if set.Add(30) || set.Add(10) || set.Add(20) {
+		t.Fatal("set add error")
+	}
+	if !set.Add(10) {
+		t.Fatal("set add exist element error")
+	}
+	if set.Count() != 3 {
+		t.Fatal("set count not eq 3")
+	}
+	if !seq.Equals[int](seq.ToSlice[int](set), seq.Slice[int]{10, 20, 30}) {
+		t.Fatal("set order error")
+	}
+	if !seq.Equals[int](seq.Slice[int](seq.CollectToSlice(set.Reversed().Iterator())), seq.Slice[int]{30, 20, 10}) {
+		t.Fatal("set reversed order error")
+	}
+	if set.First().OrPanic() != 10 || set.Last().OrPanic() != 30 {
+		t.Fatal("set first or last error")
+	}
+	if set.Floor(15).OrPanic() != 10 || set.Ceiling(15).OrPanic() != 20 {
+		t.Fatal("set floor or ceiling error")
+	}
+	if set.Lower(10).IsSome() || set.Higher(20).OrPanic() != 30 {
+		t.Fatal("set lower or higher error")
+	}
+	if set.Rank(20) != 1 || set.Rank(25) != 2 || set.Rank(5) != 0 {
+		t.Fatal("set rank error")
+	}
+	if set.Select(2).OrPanic() != 30 || set.Select(3).IsSome() {
+		t.Fatal("set select error")
+	}
+	var sub = set.SubSet(15, 30)
+	if sub.Count() != 1 || !sub.Contains(20) || sub.Contains(30) || sub.Contains(10) {
+		t.Fatal("subset error")
+	}
+	set.Add(25)
+	if sub.Count() != 2 || !seq.Equals[int](seq.ToSlice[int](sub), seq.Slice[int]{20, 25}) {
+		t.Fatal("subset view error")
+	}
+	if set.Remove(25).OrPanic() != 25 || set.Remove(25).IsSome() {
+		t.Fatal("set remove error")
+	}
+	if !set.ContainsAll(seq.Slice[int]{10, 20}) || set.ContainsAll(seq.Slice[int]{10, 15}) {
+		t.Fatal("set contains all error")
+	}
+	var clone = set.Clone()
+	clone.Clear()
+	if clone.Count() != 0 || set.Count() != 3 {
+		t.Fatal("set clone error")
+	}
+	var desc = MakeWithComparator(func(a, b string) int {
+		if a > b {
+			return -1
+		} else if a < b {
+			return 1
+		}
+		return 0
+	})
+	desc.Add("a")
+	desc.Add("b")
+	if desc.First().OrPanic() != "b" {
+		t.Fatal("set comparator error")
+	}
+	if seq.Collect[int](Collector[int](), seq.Slice[int]{3, 1, 2}).First().OrPanic() != 1 {
+		t.Fatal("set collector error")
+	}
+	set = Of(1, 2, 3, 4, 5)
+	var iter = set.Iterator().(seq.MutableIterator[int])
+	iter.Next()
+	iter.Remove()
+	if set.Count() != 4 || set.Contains(1) {
+		t.Fatal("iterator remove error")
+	}
+	set.Add(6)
+	if panics.Recover(func() { iter.Next() }) != seq.ConcurrentModification {
+		t.Fatal("fail fast error")
+	}
+}
@@ -0,0 +1,59 @@
+//go:build go1.23
+
+package stack
+
+import (
+	"iter"
+
+	"github.com/kulics/gollection/seq"
+)
+
+// Return an iter.Seq2 of the indexes and elements of stack from top to bottom,
+// which can be used in range-over-func loops. The top of the stack has index 0.
+// Like Iterator, it panics with seq.ConcurrentModification when stack is modified during the loop.
+func (a *Stack[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		var modCount = a.modCount
+		for i := a.length - 1; i >= 0; i-- {
+			a.checkModCount(modCount)
+			if !yield(a.length-1-i, a.elements[i]) {
+				return
+			}
+		}
+		a.checkModCount(modCount)
+	}
+}
+
+// Return an iter.Seq of the indexes of stack.
+func (a *Stack[T]) Keys() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		var modCount = a.modCount
+		for i := 0; i < a.length; i++ {
+			a.checkModCount(modCount)
+			if !yield(i) {
+				return
+			}
+		}
+		a.checkModCount(modCount)
+	}
+}
+
+// Return an iter.Seq of the elements of stack from top to bottom.
+func (a *Stack[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var modCount = a.modCount
+		for i := a.length - 1; i >= 0; i-- {
+			a.checkModCount(modCount)
+			if !yield(a.elements[i]) {
+				return
+			}
+		}
+		a.checkModCount(modCount)
+	}
+}
+
+func (a *Stack[T]) checkModCount(modCount int) {
+	if a.modCount != modCount {
+		panic(seq.ConcurrentModification)
+	}
+}
@@ -0,0 +1,27 @@
+//go:build go1.23
+
+package stack
+
+import (
+	"testing"
+
+	"github.com/kulics/gollection/internal/panics"
+	"github.com/kulics/gollection/seq"
+)
+
+func TestStackRange(t *testing.T) {
+	var stack = Of(1, 2, 3)
+	for i, v := range stack.All() {
+		if v != 3-i {
+			t.Fatal("stack all error")
+		}
+	}
+	var push = func() {
+		for v := range stack.Values() {
+			stack.AddLast(v)
+		}
+	}
+	if panics.Recover(push) != seq.ConcurrentModification {
+		t.Fatal("stack range fail fast error")
+	}
+}
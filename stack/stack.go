@@ -30,18 +30,20 @@ func Make[T any](capacity int) *Stack[T] {
 	if capacity < defaultElementsLength {
 		capacity = defaultElementsLength
 	}
-	return &Stack[T]{make([]T, capacity), 0}
+	return &Stack[T]{elements: make([]T, capacity)}
 }
 
 // Constructing an Stack from other Collection.
 func From[T any](collection seq.Collection[T]) *Stack[T] {
-	return &Stack[T]{seq.ToSlice(collection), collection.Count()}
+	return &Stack[T]{elements: seq.ToSlice(collection), length: collection.Count()}
 }
 
 // Stack implemented using Array.
+// The iterators panic with seq.ConcurrentModification when stack is modified during iteration.
 type Stack[T any] struct {
 	elements []T
 	length   int
+	modCount int
 }
 
 // Return the number of elements of stack.
@@ -56,6 +58,7 @@ func (a *Stack[T]) AddLast(element T) {
 	}
 	a.elements[a.length] = element
 	a.length++
+	a.modCount++
 }
 
 // Remove an element from the top of the stack.
@@ -69,6 +72,7 @@ func (a *Stack[T]) RemoveLast() option.Option[T] {
 	var empty T
 	a.elements[index] = empty
 	a.length--
+	a.modCount++
 	return option.Some(item)
 }
 
@@ -83,7 +87,7 @@ func (a *Stack[T]) Last() ref.Ref[T] {
 
 // Return the Iterator of stack.
 func (a *Stack[T]) Iterator() seq.Iterator[T] {
-	return &iterator[T]{a.Count(), a}
+	return &iterator[T]{a.Count(), a, a.modCount}
 }
 
 // Return a new stack that copies all elements.
@@ -115,6 +119,7 @@ func (a *Stack[T]) Clear() {
 		a.elements[i] = emptyValue
 	}
 	a.length = 0
+	a.modCount++
 }
 
 func (a *Stack[T]) grow(minCapacity int) {
@@ -128,11 +133,15 @@ func (a *Stack[T]) grow(minCapacity int) {
 }
 
 type iterator[T any] struct {
-	index  int
-	source *Stack[T]
+	index    int
+	source   *Stack[T]
+	modCount int
 }
 
 func (a *iterator[T]) Next() option.Option[T] {
+	if a.modCount != a.source.modCount {
+		panic(seq.ConcurrentModification)
+	}
 	if a.index > 0 {
 		a.index--
 		return option.Some(a.source.elements[a.index])
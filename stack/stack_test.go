@@ -2,6 +2,9 @@ package stack
 
 import (
 	"testing"
+
+	"github.com/kulics/gollection/internal/panics"
+	"github.com/kulics/gollection/seq"
 )
 
 func TestArrayStack(t *testing.T) {
@@ -81,4 +84,11 @@ func TestArrayStack(t *testing.T) {
 			t.Fatal("element error")
 		}
 	}
+	stack = Of(1, 2, 3)
+	iter = stack.Iterator()
+	iter.Next()
+	stack.RemoveLast()
+	if panics.Recover(func() { iter.Next() }) != seq.ConcurrentModification {
+		t.Fatal("fail fast error")
+	}
 }
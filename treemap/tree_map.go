@@ -0,0 +1,767 @@
+package treemap
+
+import (
+	"github.com/kulics/gollection/dict"
+	"github.com/kulics/gollection/option"
+	"github.com/kulics/gollection/ref"
+	"github.com/kulics/gollection/seq"
+	"golang.org/x/exp/constraints"
+)
+
+func compare[K constraints.Ordered](a, b K) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
+
+// Constructing a TreeMap with variable-length parameters.
+func Of[K constraints.Ordered, V any](elements ...dict.Entry[K, V]) *TreeMap[K, V] {
+	var tree = Make[K, V]()
+	for _, v := range elements {
+		tree.Add(v.Key, v.Value)
+	}
+	return tree
+}
+
+// Constructing an empty TreeMap ordered by the natural order of keys.
+func Make[K constraints.Ordered, V any]() *TreeMap[K, V] {
+	return MakeWithComparator[K, V](compare[K])
+}
+
+// Constructing an empty TreeMap ordered by comparator.
+// The comparator returns a negative number when a < b, zero when a == b, and a positive number when a > b.
+func MakeWithComparator[K any, V any](comparator func(a, b K) int) *TreeMap[K, V] {
+	return &TreeMap[K, V]{compare: comparator}
+}
+
+// Constructing a TreeMap from other Collection.
+func From[K constraints.Ordered, V any](collection seq.Collection[dict.Entry[K, V]]) *TreeMap[K, V] {
+	var tree = Make[K, V]()
+	seq.ForEach[dict.Entry[K, V]](func(t dict.Entry[K, V]) {
+		tree.Add(t.Key, t.Value)
+	}, collection)
+	return tree
+}
+
+const (
+	red   = false
+	black = true
+)
+
+// TreeMap implemented using red-black tree, the entries are kept in the order of keys.
+// The iterators panic with seq.ConcurrentModification when entries are added or removed during iteration,
+// except by the Remove of the iterator itself.
+type TreeMap[K any, V any] struct {
+	root     *node[K, V]
+	length   int
+	compare  func(K, K) int
+	modCount int
+}
+
+type node[K any, V any] struct {
+	key    K
+	value  V
+	left   *node[K, V]
+	right  *node[K, V]
+	parent *node[K, V]
+	color  bool
+	size   int
+}
+
+// Return the number of entries of tree.
+func (a *TreeMap[K, V]) Count() int {
+	return a.length
+}
+
+// Returns true if the key is included in the tree.
+func (a *TreeMap[K, V]) Contains(key K) bool {
+	return a.find(key) != nil
+}
+
+// Return the value of the key.
+// Return nil when the key is not included.
+func (a *TreeMap[K, V]) At(key K) ref.Ref[V] {
+	if x := a.find(key); x != nil {
+		return ref.Of(&x.value)
+	}
+	return ref.Of[V](nil)
+}
+
+// Add the value of the key, return the old value when the key has been included.
+func (a *TreeMap[K, V]) Add(key K, value V) option.Option[V] {
+	var x = a.root
+	if x == nil {
+		a.root = &node[K, V]{key: key, value: value, color: black, size: 1}
+		a.length = 1
+		a.modCount++
+		return option.None[V]()
+	}
+	var parent *node[K, V]
+	var c int
+	for x != nil {
+		parent = x
+		c = a.compare(key, x.key)
+		if c < 0 {
+			x = x.left
+		} else if c > 0 {
+			x = x.right
+		} else {
+			var old = x.value
+			x.value = value
+			return option.Some(old)
+		}
+	}
+	var newNode = &node[K, V]{key: key, value: value, parent: parent, color: red, size: 1}
+	if c < 0 {
+		parent.left = newNode
+	} else {
+		parent.right = newNode
+	}
+	for p := parent; p != nil; p = p.parent {
+		p.size++
+	}
+	a.fixAfterInsertion(newNode)
+	a.length++
+	a.modCount++
+	return option.None[V]()
+}
+
+// Remove the key, return the removed value when the key has been included.
+func (a *TreeMap[K, V]) Remove(key K) option.Option[V] {
+	var x = a.find(key)
+	if x == nil {
+		return option.None[V]()
+	}
+	var old = x.value
+	a.delete(x)
+	return option.Some(old)
+}
+
+// Clears all entries.
+func (a *TreeMap[K, V]) Clear() {
+	a.root = nil
+	a.length = 0
+	a.modCount++
+}
+
+// Return the entry with the least key.
+// Return None when the tree is empty.
+func (a *TreeMap[K, V]) FirstEntry() option.Option[dict.Entry[K, V]] {
+	return entryOf(a.first())
+}
+
+// Return the entry with the greatest key.
+// Return None when the tree is empty.
+func (a *TreeMap[K, V]) LastEntry() option.Option[dict.Entry[K, V]] {
+	return entryOf(a.last())
+}
+
+// Return the entry with the greatest key less than or equal to the key.
+func (a *TreeMap[K, V]) Floor(key K) option.Option[dict.Entry[K, V]] {
+	return entryOf(a.floor(key))
+}
+
+// Return the entry with the least key greater than or equal to the key.
+func (a *TreeMap[K, V]) Ceiling(key K) option.Option[dict.Entry[K, V]] {
+	return entryOf(a.ceiling(key))
+}
+
+// Return the entry with the greatest key strictly less than the key.
+func (a *TreeMap[K, V]) Lower(key K) option.Option[dict.Entry[K, V]] {
+	return entryOf(a.lower(key))
+}
+
+// Return the entry with the least key strictly greater than the key.
+func (a *TreeMap[K, V]) Higher(key K) option.Option[dict.Entry[K, V]] {
+	return entryOf(a.higher(key))
+}
+
+// Return the number of keys strictly less than the key.
+func (a *TreeMap[K, V]) Rank(key K) int {
+	var rank = 0
+	for x := a.root; x != nil; {
+		if a.compare(key, x.key) > 0 {
+			rank += sizeOf(x.left) + 1
+			x = x.right
+		} else {
+			x = x.left
+		}
+	}
+	return rank
+}
+
+// Return the entry at the index in ascending order of keys.
+// Return None when the index is out of bounds.
+func (a *TreeMap[K, V]) Select(index int) option.Option[dict.Entry[K, V]] {
+	if index < 0 || index >= a.length {
+		return option.None[dict.Entry[K, V]]()
+	}
+	var x = a.root
+	for x != nil {
+		var leftSize = sizeOf(x.left)
+		if index < leftSize {
+			x = x.left
+		} else if index > leftSize {
+			index -= leftSize + 1
+			x = x.right
+		} else {
+			break
+		}
+	}
+	return entryOf(x)
+}
+
+// Return the Iterator of tree, the entries are in ascending order of keys, it implements seq.MutableIterator.
+func (a *TreeMap[K, V]) Iterator() seq.Iterator[dict.Entry[K, V]] {
+	return &ascendingIterator[K, V]{next: a.first(), source: a, modCount: a.modCount}
+}
+
+// Return a Sequence of the entries in descending order of keys.
+func (a *TreeMap[K, V]) Reversed() seq.Sequence[dict.Entry[K, V]] {
+	return descendingSequence[K, V]{a}
+}
+
+// Return a Sequence of the entries whose keys are between from (inclusive) and to (exclusive),
+// in ascending order of keys.
+func (a *TreeMap[K, V]) Range(from, to K) seq.Sequence[dict.Entry[K, V]] {
+	return rangeSequence[K, V]{a, from, to}
+}
+
+// Return a new tree that copies all entries.
+func (a *TreeMap[K, V]) Clone() *TreeMap[K, V] {
+	return &TreeMap[K, V]{
+		root:    cloneNode(a.root, nil),
+		length:  a.length,
+		compare: a.compare,
+	}
+}
+
+func cloneNode[K any, V any](x *node[K, V], parent *node[K, V]) *node[K, V] {
+	if x == nil {
+		return nil
+	}
+	var newNode = &node[K, V]{key: x.key, value: x.value, parent: parent, color: x.color, size: x.size}
+	newNode.left = cloneNode(x.left, newNode)
+	newNode.right = cloneNode(x.right, newNode)
+	return newNode
+}
+
+func entryOf[K any, V any](x *node[K, V]) option.Option[dict.Entry[K, V]] {
+	if x == nil {
+		return option.None[dict.Entry[K, V]]()
+	}
+	return option.Some(dict.Entry[K, V]{Key: x.key, Value: x.value})
+}
+
+func (a *TreeMap[K, V]) find(key K) *node[K, V] {
+	for x := a.root; x != nil; {
+		var c = a.compare(key, x.key)
+		if c < 0 {
+			x = x.left
+		} else if c > 0 {
+			x = x.right
+		} else {
+			return x
+		}
+	}
+	return nil
+}
+
+func (a *TreeMap[K, V]) first() *node[K, V] {
+	var x = a.root
+	if x != nil {
+		for x.left != nil {
+			x = x.left
+		}
+	}
+	return x
+}
+
+func (a *TreeMap[K, V]) last() *node[K, V] {
+	var x = a.root
+	if x != nil {
+		for x.right != nil {
+			x = x.right
+		}
+	}
+	return x
+}
+
+func (a *TreeMap[K, V]) floor(key K) *node[K, V] {
+	var result *node[K, V]
+	for x := a.root; x != nil; {
+		var c = a.compare(key, x.key)
+		if c > 0 {
+			result = x
+			x = x.right
+		} else if c < 0 {
+			x = x.left
+		} else {
+			return x
+		}
+	}
+	return result
+}
+
+func (a *TreeMap[K, V]) ceiling(key K) *node[K, V] {
+	var result *node[K, V]
+	for x := a.root; x != nil; {
+		var c = a.compare(key, x.key)
+		if c < 0 {
+			result = x
+			x = x.left
+		} else if c > 0 {
+			x = x.right
+		} else {
+			return x
+		}
+	}
+	return result
+}
+
+func (a *TreeMap[K, V]) lower(key K) *node[K, V] {
+	var result *node[K, V]
+	for x := a.root; x != nil; {
+		if a.compare(key, x.key) > 0 {
+			result = x
+			x = x.right
+		} else {
+			x = x.left
+		}
+	}
+	return result
+}
+
+func (a *TreeMap[K, V]) higher(key K) *node[K, V] {
+	var result *node[K, V]
+	for x := a.root; x != nil; {
+		if a.compare(key, x.key) < 0 {
+			result = x
+			x = x.left
+		} else {
+			x = x.right
+		}
+	}
+	return result
+}
+
+func successor[K any, V any](x *node[K, V]) *node[K, V] {
+	if x.right != nil {
+		var p = x.right
+		for p.left != nil {
+			p = p.left
+		}
+		return p
+	}
+	var p = x.parent
+	var ch = x
+	for p != nil && ch == p.right {
+		ch = p
+		p = p.parent
+	}
+	return p
+}
+
+func predecessor[K any, V any](x *node[K, V]) *node[K, V] {
+	if x.left != nil {
+		var p = x.left
+		for p.right != nil {
+			p = p.right
+		}
+		return p
+	}
+	var p = x.parent
+	var ch = x
+	for p != nil && ch == p.left {
+		ch = p
+		p = p.parent
+	}
+	return p
+}
+
+func (a *TreeMap[K, V]) delete(p *node[K, V]) {
+	a.length--
+	a.modCount++
+	// If strictly internal, swap p with its successor, so the refs of the successor stay valid.
+	if p.left != nil && p.right != nil {
+		a.swap(p, successor(p))
+	}
+	for x := p.parent; x != nil; x = x.parent {
+		x.size--
+	}
+	var replacement = p.left
+	if replacement == nil {
+		replacement = p.right
+	}
+	if replacement != nil {
+		replacement.parent = p.parent
+		if p.parent == nil {
+			a.root = replacement
+		} else if p == p.parent.left {
+			p.parent.left = replacement
+		} else {
+			p.parent.right = replacement
+		}
+		p.left = nil
+		p.right = nil
+		p.parent = nil
+		if p.color == black {
+			a.fixAfterDeletion(replacement)
+		}
+	} else if p.parent == nil {
+		a.root = nil
+	} else {
+		// The node is still linked during the fix, but no longer counted in the size.
+		p.size = 0
+		if p.color == black {
+			a.fixAfterDeletion(p)
+		}
+		if p.parent != nil {
+			if p == p.parent.left {
+				p.parent.left = nil
+			} else if p == p.parent.right {
+				p.parent.right = nil
+			}
+			p.parent = nil
+		}
+	}
+}
+
+// Swap the positions of p and its successor s in the tree, s has no left child.
+func (a *TreeMap[K, V]) swap(p *node[K, V], s *node[K, V]) {
+	var parent = p.parent
+	var left = p.left
+	var right = p.right
+	var sRight = s.right
+	if parent == nil {
+		a.root = s
+	} else if p == parent.left {
+		parent.left = s
+	} else {
+		parent.right = s
+	}
+	if right == s {
+		s.right = p
+		p.parent = s
+	} else {
+		s.right = right
+		right.parent = s
+		p.parent = s.parent
+		s.parent.left = p
+	}
+	s.parent = parent
+	s.left = left
+	left.parent = s
+	p.left = nil
+	p.right = sRight
+	if sRight != nil {
+		sRight.parent = p
+	}
+	p.color, s.color = s.color, p.color
+	p.size, s.size = s.size, p.size
+}
+
+func colorOf[K any, V any](p *node[K, V]) bool {
+	if p == nil {
+		return black
+	}
+	return p.color
+}
+
+func sizeOf[K any, V any](p *node[K, V]) int {
+	if p == nil {
+		return 0
+	}
+	return p.size
+}
+
+func parentOf[K any, V any](p *node[K, V]) *node[K, V] {
+	if p == nil {
+		return nil
+	}
+	return p.parent
+}
+
+func setColor[K any, V any](p *node[K, V], c bool) {
+	if p != nil {
+		p.color = c
+	}
+}
+
+func leftOf[K any, V any](p *node[K, V]) *node[K, V] {
+	if p == nil {
+		return nil
+	}
+	return p.left
+}
+
+func rightOf[K any, V any](p *node[K, V]) *node[K, V] {
+	if p == nil {
+		return nil
+	}
+	return p.right
+}
+
+func (a *TreeMap[K, V]) rotateLeft(p *node[K, V]) {
+	if p == nil {
+		return
+	}
+	var r = p.right
+	p.right = r.left
+	if r.left != nil {
+		r.left.parent = p
+	}
+	r.parent = p.parent
+	if p.parent == nil {
+		a.root = r
+	} else if p.parent.left == p {
+		p.parent.left = r
+	} else {
+		p.parent.right = r
+	}
+	r.left = p
+	p.parent = r
+	r.size = p.size
+	p.size = sizeOf(p.left) + sizeOf(p.right) + 1
+}
+
+func (a *TreeMap[K, V]) rotateRight(p *node[K, V]) {
+	if p == nil {
+		return
+	}
+	var l = p.left
+	p.left = l.right
+	if l.right != nil {
+		l.right.parent = p
+	}
+	l.parent = p.parent
+	if p.parent == nil {
+		a.root = l
+	} else if p.parent.right == p {
+		p.parent.right = l
+	} else {
+		p.parent.left = l
+	}
+	l.right = p
+	p.parent = l
+	l.size = p.size
+	p.size = sizeOf(p.left) + sizeOf(p.right) + 1
+}
+
+func (a *TreeMap[K, V]) fixAfterInsertion(x *node[K, V]) {
+	x.color = red
+	for x != nil && x != a.root && x.parent.color == red {
+		if parentOf(x) == leftOf(parentOf(parentOf(x))) {
+			var y = rightOf(parentOf(parentOf(x)))
+			if colorOf(y) == red {
+				setColor(parentOf(x), black)
+				setColor(y, black)
+				setColor(parentOf(parentOf(x)), red)
+				x = parentOf(parentOf(x))
+			} else {
+				if x == rightOf(parentOf(x)) {
+					x = parentOf(x)
+					a.rotateLeft(x)
+				}
+				setColor(parentOf(x), black)
+				setColor(parentOf(parentOf(x)), red)
+				a.rotateRight(parentOf(parentOf(x)))
+			}
+		} else {
+			var y = leftOf(parentOf(parentOf(x)))
+			if colorOf(y) == red {
+				setColor(parentOf(x), black)
+				setColor(y, black)
+				setColor(parentOf(parentOf(x)), red)
+				x = parentOf(parentOf(x))
+			} else {
+				if x == leftOf(parentOf(x)) {
+					x = parentOf(x)
+					a.rotateRight(x)
+				}
+				setColor(parentOf(x), black)
+				setColor(parentOf(parentOf(x)), red)
+				a.rotateLeft(parentOf(parentOf(x)))
+			}
+		}
+	}
+	a.root.color = black
+}
+
+func (a *TreeMap[K, V]) fixAfterDeletion(x *node[K, V]) {
+	for x != a.root && colorOf(x) == black {
+		if x == leftOf(parentOf(x)) {
+			var sib = rightOf(parentOf(x))
+			if colorOf(sib) == red {
+				setColor(sib, black)
+				setColor(parentOf(x), red)
+				a.rotateLeft(parentOf(x))
+				sib = rightOf(parentOf(x))
+			}
+			if colorOf(leftOf(sib)) == black && colorOf(rightOf(sib)) == black {
+				setColor(sib, red)
+				x = parentOf(x)
+			} else {
+				if colorOf(rightOf(sib)) == black {
+					setColor(leftOf(sib), black)
+					setColor(sib, red)
+					a.rotateRight(sib)
+					sib = rightOf(parentOf(x))
+				}
+				setColor(sib, colorOf(parentOf(x)))
+				setColor(parentOf(x), black)
+				setColor(rightOf(sib), black)
+				a.rotateLeft(parentOf(x))
+				x = a.root
+			}
+		} else {
+			var sib = leftOf(parentOf(x))
+			if colorOf(sib) == red {
+				setColor(sib, black)
+				setColor(parentOf(x), red)
+				a.rotateRight(parentOf(x))
+				sib = leftOf(parentOf(x))
+			}
+			if colorOf(rightOf(sib)) == black && colorOf(leftOf(sib)) == black {
+				setColor(sib, red)
+				x = parentOf(x)
+			} else {
+				if colorOf(leftOf(sib)) == black {
+					setColor(rightOf(sib), black)
+					setColor(sib, red)
+					a.rotateLeft(sib)
+					sib = leftOf(parentOf(x))
+				}
+				setColor(sib, colorOf(parentOf(x)))
+				setColor(parentOf(x), black)
+				setColor(leftOf(sib), black)
+				a.rotateRight(parentOf(x))
+				x = a.root
+			}
+		}
+	}
+	setColor(x, black)
+}
+
+type ascendingIterator[K any, V any] struct {
+	next         *node[K, V]
+	bound        func(K) bool
+	lastReturned *node[K, V]
+	source       *TreeMap[K, V]
+	modCount     int
+}
+
+func (a *ascendingIterator[K, V]) Next() option.Option[dict.Entry[K, V]] {
+	if a.modCount != a.source.modCount {
+		panic(seq.ConcurrentModification)
+	}
+	var x = a.next
+	if x == nil || (a.bound != nil && !a.bound(x.key)) {
+		a.lastReturned = nil
+		return option.None[dict.Entry[K, V]]()
+	}
+	a.next = successor(x)
+	a.lastReturned = x
+	return option.Some(dict.Entry[K, V]{Key: x.key, Value: x.value})
+}
+
+func (a *ascendingIterator[K, V]) Remove() {
+	if a.lastReturned == nil {
+		panic(seq.IllegalState)
+	}
+	if a.modCount != a.source.modCount {
+		panic(seq.ConcurrentModification)
+	}
+	// The successor keeps its node after the deletion, so the next node is still valid.
+	a.source.delete(a.lastReturned)
+	a.lastReturned = nil
+	a.modCount = a.source.modCount
+}
+
+type descendingSequence[K any, V any] struct {
+	source *TreeMap[K, V]
+}
+
+func (a descendingSequence[K, V]) Iterator() seq.Iterator[dict.Entry[K, V]] {
+	return &descendingIterator[K, V]{next: a.source.last(), source: a.source, modCount: a.source.modCount}
+}
+
+type descendingIterator[K any, V any] struct {
+	next         *node[K, V]
+	lastReturned *node[K, V]
+	source       *TreeMap[K, V]
+	modCount     int
+}
+
+func (a *descendingIterator[K, V]) Next() option.Option[dict.Entry[K, V]] {
+	if a.modCount != a.source.modCount {
+		panic(seq.ConcurrentModification)
+	}
+	var x = a.next
+	if x == nil {
+		a.lastReturned = nil
+		return option.None[dict.Entry[K, V]]()
+	}
+	a.next = predecessor(x)
+	a.lastReturned = x
+	return option.Some(dict.Entry[K, V]{Key: x.key, Value: x.value})
+}
+
+func (a *descendingIterator[K, V]) Remove() {
+	if a.lastReturned == nil {
+		panic(seq.IllegalState)
+	}
+	if a.modCount != a.source.modCount {
+		panic(seq.ConcurrentModification)
+	}
+	// The predecessor keeps its node after the deletion, so the next node is still valid.
+	a.source.delete(a.lastReturned)
+	a.lastReturned = nil
+	a.modCount = a.source.modCount
+}
+
+type rangeSequence[K any, V any] struct {
+	source *TreeMap[K, V]
+	from   K
+	to     K
+}
+
+func (a rangeSequence[K, V]) Iterator() seq.Iterator[dict.Entry[K, V]] {
+	var compare = a.source.compare
+	var to = a.to
+	return &ascendingIterator[K, V]{
+		next: a.source.ceiling(a.from),
+		bound: func(key K) bool {
+			return compare(key, to) < 0
+		},
+		source:   a.source,
+		modCount: a.source.modCount,
+	}
+}
+
+func Collector[K constraints.Ordered, V any]() seq.Collector[*TreeMap[K, V], dict.Entry[K, V], *TreeMap[K, V]] {
+	return collector[K, V]{compare[K]}
+}
+
+func CollectorWithComparator[K any, V any](comparator func(a, b K) int) seq.Collector[*TreeMap[K, V], dict.Entry[K, V], *TreeMap[K, V]] {
+	return collector[K, V]{comparator}
+}
+
+type collector[K any, V any] struct {
+	compare func(K, K) int
+}
+
+func (a collector[K, V]) Builder() *TreeMap[K, V] {
+	return MakeWithComparator[K, V](a.compare)
+}
+
+func (a collector[K, V]) Append(supplier *TreeMap[K, V], element dict.Entry[K, V]) {
+	supplier.Add(element.Key, element.Value)
+}
+
+func (a collector[K, V]) Finish(supplier *TreeMap[K, V]) *TreeMap[K, V] {
+	return supplier
+}
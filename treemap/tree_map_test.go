@@ -0,0 +1,212 @@
+package treemap
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/kulics/gollection/dict"
+	"github.com/kulics/gollection/internal/panics"
+	"github.com/kulics/gollection/ref"
+	"github.com/kulics/gollection/seq"
+)
+
+func blackHeight[K any, V any](t *testing.T, x *node[K, V]) int {
+	if x == nil {
+		return 1
+	}
+	if x.size != sizeOf(x.left)+sizeOf(x.right)+1 {
+		t.Fatal("size of node error")
+	}
+	if x.color == red && (colorOf(x.left) == red || colorOf(x.right) == red) {
+		t.Fatal("red node has red child")
+	}
+	var l = blackHeight(t, x.left)
+	if l != blackHeight(t, x.right) {
+		t.Fatal("black height not eq")
+	}
+	if x.color == black {
+		return l + 1
+	}
+	return l
+}
+
+func TestTreeMap(t *testing.T) {
+	var tree = Of[int, string]()
+	if tree.Count() != 0 {
+		t.Fatal("tree count not eq 0")
+	}
+	if tree.FirstEntry().IsSome() || tree.LastEntry().IsSome() {
+		t.Fatal("empty tree has entry")
+	}
+	tree.Add(1, "1")
+	if tree.Count() != 1 {
+		t.Fatal("tree count not eq 1")
+	}
+	if old := tree.Add(1, "a"); old.OrPanic() != "1" {
+		t.Fatal("old value not eq 1")
+	}
+	if tree.At(1).Get() != "a" {
+		t.Fatal("tree value not eq a")
+	}
+	tree.At(1).Set("b")
+	if tree.At(1).Get() != "b" {
+		t.Fatal("tree value not eq b")
+	}
+	if tree.At(2).IsNotNil() {
+		t.Fatal("tree has not key 2")
+	}
+	var refs = Make[int, int]()
+	for i := 0; i < 64; i++ {
+		refs.Add(i, i)
+	}
+	var five = refs.At(5)
+	refs.Remove(4)
+	five.Set(555)
+	if refs.At(5).Get() != 555 {
+		t.Fatal("tree ref after remove error")
+	}
+	var odds = make([]ref.Ref[int], 64)
+	for i := 1; i < 64; i += 2 {
+		odds[i] = refs.At(i)
+	}
+	for i := 0; i < 64; i += 2 {
+		refs.Remove(i)
+	}
+	blackHeight(t, refs.root)
+	for i := 1; i < 64; i += 2 {
+		odds[i].Set(-i)
+		if refs.At(i).Get() != -i {
+			t.Fatal("tree refs after remove error")
+		}
+	}
+	var expect = map[int]int{}
+	var random = rand.New(rand.NewSource(1))
+	var ints = Make[int, int]()
+	for i := 0; i < 2000; i++ {
+		var k = random.Intn(500)
+		if random.Intn(3) == 0 {
+			_, ok := expect[k]
+			if ints.Remove(k).IsSome() != ok {
+				t.Fatal("tree remove error")
+			}
+			delete(expect, k)
+		} else {
+			expect[k] = i
+			ints.Add(k, i)
+		}
+		if ints.Count() != len(expect) {
+			t.Fatal("tree count error")
+		}
+	}
+	blackHeight(t, ints.root)
+	for k, v := range expect {
+		if ints.At(k).Get() != v {
+			t.Fatal("tree value error")
+		}
+	}
+	var last = -1
+	seq.ForEach[dict.Entry[int, int]](func(e dict.Entry[int, int]) {
+		if e.Key <= last {
+			t.Fatal("tree iterate order error")
+		}
+		last = e.Key
+	}, ints)
+	if seq.Count[dict.Entry[int, int]](ints) != len(expect) {
+		t.Fatal("tree iterate count error")
+	}
+	last = 500
+	seq.ForEach(func(e dict.Entry[int, int]) {
+		if e.Key >= last {
+			t.Fatal("tree reversed order error")
+		}
+		last = e.Key
+	}, ints.Reversed())
+
+	var index = 0
+	seq.ForEach[dict.Entry[int, int]](func(e dict.Entry[int, int]) {
+		if ints.Rank(e.Key) != index || ints.Select(index).OrPanic().Key != e.Key {
+			t.Fatal("rank or select error")
+		}
+		index++
+	}, ints)
+	if ints.Select(-1).IsSome() || ints.Select(ints.Count()).IsSome() {
+		t.Fatal("select out of bounds error")
+	}
+
+	var tens = Of(dict.Entry[int, int]{Key: 10, Value: 1}, dict.Entry[int, int]{Key: 20, Value: 2}, dict.Entry[int, int]{Key: 30, Value: 3})
+	if tens.FirstEntry().OrPanic().Key != 10 || tens.LastEntry().OrPanic().Key != 30 {
+		t.Fatal("first or last entry error")
+	}
+	if tens.Floor(25).OrPanic().Key != 20 || tens.Floor(20).OrPanic().Key != 20 || tens.Floor(5).IsSome() {
+		t.Fatal("floor error")
+	}
+	if tens.Ceiling(25).OrPanic().Key != 30 || tens.Ceiling(20).OrPanic().Key != 20 || tens.Ceiling(35).IsSome() {
+		t.Fatal("ceiling error")
+	}
+	if tens.Lower(20).OrPanic().Key != 10 || tens.Lower(10).IsSome() {
+		t.Fatal("lower error")
+	}
+	if tens.Higher(20).OrPanic().Key != 30 || tens.Higher(30).IsSome() {
+		t.Fatal("higher error")
+	}
+	var keys = seq.Map(func(e dict.Entry[int, int]) int {
+		return e.Key
+	}, tens.Range(15, 30))
+	if !seq.Equals[int](seq.Slice[int](seq.CollectToSlice(keys.Iterator())), seq.Slice[int]{20}) {
+		t.Fatal("range error")
+	}
+	var clone = tens.Clone()
+	clone.Remove(10)
+	if clone.Count() != 2 || tens.Count() != 3 {
+		t.Fatal("clone error")
+	}
+	var reversed = seq.Collect[dict.Entry[int, int]](CollectorWithComparator[int, int](func(a, b int) int {
+		return b - a
+	}), tens)
+	if reversed.FirstEntry().OrPanic().Key != 30 {
+		t.Fatal("comparator error")
+	}
+	ints = Make[int, int]()
+	for i := 0; i < 100; i++ {
+		ints.Add(i, i)
+	}
+	var iter = ints.Iterator().(seq.MutableIterator[dict.Entry[int, int]])
+	var visited = 0
+	for {
+		if v, ok := iter.Next().Val(); ok {
+			visited++
+			if v.Key%2 == 0 {
+				iter.Remove()
+			}
+		} else {
+			break
+		}
+	}
+	if visited != 100 || ints.Count() != 50 || ints.Contains(50) || !ints.Contains(51) {
+		t.Fatal("iterator remove error")
+	}
+	var descending = ints.Reversed().Iterator().(seq.MutableIterator[dict.Entry[int, int]])
+	visited = 0
+	for {
+		if v, ok := descending.Next().Val(); ok {
+			visited++
+			if v.Key > 50 {
+				descending.Remove()
+			}
+		} else {
+			break
+		}
+	}
+	if visited != 50 || ints.Count() != 25 || ints.LastEntry().OrPanic().Key != 49 {
+		t.Fatal("descending iterator remove error")
+	}
+	var ranged = ints.Range(10, 20).Iterator()
+	ranged.Next()
+	ints.Remove(15)
+	if panics.Recover(func() { ranged.Next() }) != seq.ConcurrentModification {
+		t.Fatal("fail fast error")
+	}
+	if panics.Recover(ints.Iterator().(seq.MutableIterator[dict.Entry[int, int]]).Remove) != seq.IllegalState {
+		t.Fatal("remove before next error")
+	}
+}